@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+var archiveFlagYear int
+var archiveFlagMonth int
+
+var archiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Fetch all articles of a given month from the New York Times archive.",
+	Long: `Fetch all articles of a given month from the New York Times archive.
+
+	The archive reaches back to September 1851. Articles are printed while
+	they are received, with --json every article is printed as a JSON object
+	on its own line. As a month may take a while to be received, the request
+	is not timed out unless --timeout is given explicitly.
+
+	Example usage:
+		gonyt archive -y 2021 -m 6
+		gonyt archive -y 1969 -m 7 --json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		options := []nytapi.Option{}
+		if !cmd.Flags().Changed("timeout") {
+			options = append(options, nytapi.WithDefaultTimeout(0))
+		}
+		client, err := newCLIClient(options...)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		err = client.FetchArchive(ctx, archiveFlagYear, time.Month(archiveFlagMonth), func(document nytapi.ArchiveDocument) error {
+			return printStreamedSearchDocument(&document)
+		})
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(archiveCmd)
+
+	archiveCmd.Flags().IntVarP(&archiveFlagYear, "year", "y", 0, "Year of the archive month to be fetched.")
+	archiveCmd.MarkFlagRequired("year")
+	archiveCmd.Flags().IntVarP(&archiveFlagMonth, "month", "m", 0, "Month (1-12) of the archive month to be fetched.")
+	archiveCmd.MarkFlagRequired("month")
+}
@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+var bestsellersFlagList string
+var bestsellersFlagDate string
+var bestsellersHistoryFlagAuthor string
+var bestsellersHistoryFlagTitle string
+var bestsellersHistoryFlagIsbn string
+var bestsellersHistoryFlagPublisher string
+var bestsellersHistoryFlagAgeGroup string
+var bestsellersHistoryFlagOffset int
+
+const bestsellersDateFormat = "2006-01-02"
+
+var bestsellersCmd = &cobra.Command{
+	Use:   "bestsellers",
+	Short: "Fetch a bestseller list from the New York Times.",
+	Long: `Fetch a bestseller list from the New York Times.
+
+	Lists are identified by their encoded name, e.g.
+		hardcover-fiction, hardcover-nonfiction,
+		combined-print-and-e-book-fiction, young-adult-hardcover
+
+	Dates are given in the format YYYY-MM-DD, without a date the current list is fetched.
+	All available lists are shown by 'gonyt bestsellers names',
+	the top books of all lists by 'gonyt bestsellers overview' and
+	the ranking history of books by 'gonyt bestsellers history'.
+
+	Example usage:
+		gonyt bestsellers -l hardcover-fiction
+		gonyt bestsellers -l hardcover-nonfiction -d 2021-06-01`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		date, err := parseBestsellersDate(bestsellersFlagDate)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		bestsellerList, err := client.FetchBestsellerList(ctx, bestsellersFlagList, date)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printBestsellerList(bestsellerList)
+	},
+}
+
+var bestsellersNamesCmd = &cobra.Command{
+	Use:   "names",
+	Short: "Fetch the names of all bestseller lists from the New York Times.",
+	Long: `Fetch the names of all bestseller lists from the New York Times.
+
+	Example usage:
+		gonyt bestsellers names`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		listNames, err := client.FetchBestsellerListNames(ctx)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printBestsellerListNames(listNames)
+	},
+}
+
+var bestsellersOverviewCmd = &cobra.Command{
+	Use:   "overview",
+	Short: "Fetch the top books of all bestseller lists from the New York Times.",
+	Long: `Fetch the top books of all bestseller lists from the New York Times.
+
+	Dates are given in the format YYYY-MM-DD, without a date the latest lists are fetched.
+
+	Example usage:
+		gonyt bestsellers overview
+		gonyt bestsellers overview -d 2021-06-27`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		date, err := parseBestsellersDate(bestsellersFlagDate)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		overview, err := client.FetchBestsellerOverview(ctx, date)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printBestsellerOverview(overview)
+	},
+}
+
+var bestsellersHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Fetch the bestseller history of books from the New York Times.",
+	Long: `Fetch the bestseller history of books from the New York Times.
+
+	Books can be filtered by author, title, ISBN, publisher and age group.
+	Results are delivered in pages of 20, the offset has to be a multiple of 20.
+
+	Example usage:
+		gonyt bestsellers history --author "Delia Owens"
+		gonyt bestsellers history --isbn 9780735219090
+		gonyt bestsellers history --publisher Putnam --offset 20`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+		historyQuery := nytapi.BestsellerHistoryQuery{
+			Author:    bestsellersHistoryFlagAuthor,
+			Title:     bestsellersHistoryFlagTitle,
+			Isbn:      bestsellersHistoryFlagIsbn,
+			Publisher: bestsellersHistoryFlagPublisher,
+			AgeGroup:  bestsellersHistoryFlagAgeGroup,
+			Offset:    bestsellersHistoryFlagOffset,
+		}
+
+		history, numResults, err := client.FetchBestsellerHistory(ctx, historyQuery)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printBestsellerHistory(history, numResults)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(bestsellersCmd)
+	bestsellersCmd.AddCommand(bestsellersNamesCmd)
+	bestsellersCmd.AddCommand(bestsellersOverviewCmd)
+	bestsellersCmd.AddCommand(bestsellersHistoryCmd)
+
+	bestsellersCmd.Flags().StringVarP(&bestsellersFlagList, "list", "l", "", "Encoded name of the bestseller list to be fetched.")
+	bestsellersCmd.MarkFlagRequired("list")
+	bestsellersCmd.RegisterFlagCompletionFunc("list", completeBestsellerListNames)
+	bestsellersCmd.Flags().StringVarP(&bestsellersFlagDate, "date", "d", "", "Published date (YYYY-MM-DD) of the bestseller list.")
+	bestsellersOverviewCmd.Flags().StringVarP(&bestsellersFlagDate, "date", "d", "", "Published date (YYYY-MM-DD) of the bestseller lists.")
+
+	bestsellersHistoryCmd.Flags().StringVar(&bestsellersHistoryFlagAuthor, "author", "", "Author of the books to look up.")
+	bestsellersHistoryCmd.Flags().StringVar(&bestsellersHistoryFlagTitle, "title", "", "Title of the books to look up.")
+	bestsellersHistoryCmd.Flags().StringVar(&bestsellersHistoryFlagIsbn, "isbn", "", "ISBN-10 or ISBN-13 of the book to look up.")
+	bestsellersHistoryCmd.Flags().StringVar(&bestsellersHistoryFlagPublisher, "publisher", "", "Publisher of the books to look up.")
+	bestsellersHistoryCmd.Flags().StringVar(&bestsellersHistoryFlagAgeGroup, "age-group", "", "Target age group of the books to look up.")
+	bestsellersHistoryCmd.Flags().IntVar(&bestsellersHistoryFlagOffset, "offset", 0, "Offset of the results to be fetched, a multiple of 20.")
+}
+
+// Parses a CLI supplied date, treating an empty value as the current list
+func parseBestsellersDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse(bestsellersDateFormat, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %v, expected format YYYY-MM-DD", value)
+	}
+	return date, nil
+}
+
+// Completes bestseller list names with the lists currently published by the API
+func completeBestsellerListNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	client, err := newCLIClient()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	listNames, err := client.FetchBestsellerListNames(context.Background())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	completions := []string{}
+	for _, listName := range *listNames {
+		if strings.HasPrefix(listName.ListNameEncoded, toComplete) {
+			completions = append(completions, listName.ListNameEncoded+"\t"+listName.DisplayName)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
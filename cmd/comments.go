@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+var commentsFlagSort string
+var commentsFlagMax int
+
+var commentsCmd = &cobra.Command{
+	Use:   "comments <url>",
+	Short: "Read the reader comments on a New York Times article.",
+	Long: `Read the reader comments on a New York Times article.
+
+	All pages of comments are fetched including their complete reply threads,
+	which are printed indented below the comment they answer. Threads are printed
+	while they are received, with --json every thread is printed as a JSON object
+	on its own line.
+
+	Sort orders include:
+		newest, oldest, reader
+
+	Example usage:
+		gonyt comments https://www.nytimes.com/2019/06/21/science/giant-squid-cephalopod-video.html
+		gonyt comments https://www.nytimes.com/2019/06/21/science/giant-squid-cephalopod-video.html --sort reader --max 10`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		printed := 0
+		it := client.IterateComments(ctx, args[0], nytapi.CommentSort(commentsFlagSort))
+		for it.Next() {
+			comment := it.Comment()
+			if err := printStreamedComment(&comment); err != nil {
+				fmt.Println("Error printing JSON!", err)
+				return
+			}
+			printed++
+			if commentsFlagMax > 0 && printed >= commentsFlagMax {
+				break
+			}
+		}
+		if err := it.Err(); err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(commentsCmd)
+
+	commentsCmd.Flags().StringVar(&commentsFlagSort, "sort", "", "Sort order of comments.")
+	commentsCmd.Flags().IntVar(&commentsFlagMax, "max", 0, "Maximum number of threads to be fetched, 0 for no limit.")
+}
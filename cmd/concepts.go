@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+var conceptsFlagQuery string
+var conceptsFlagType string
+var conceptsFlagFields []string
+var conceptsGraphFlagDepth int
+var conceptsGraphFlagMax int
+var conceptsGraphFlagInterval time.Duration
+var conceptsGraphFlagFormat string
+
+var conceptsCmd = &cobra.Command{
+	Use:   "concepts",
+	Short: "Search and fetch concepts of the New York Times.",
+	Long: `Search and fetch concepts of the New York Times.
+
+	Concepts are the people, places, organizations and descriptors articles are tagged with.
+	They are searched by 'gonyt concepts search', fetched in detail by 'gonyt concepts fetch'
+	and their relations are explored by 'gonyt concepts graph'.
+
+	Concept types include:
+		nytd_des, nytd_geo, nytd_org, nytd_per`,
+}
+
+var conceptsSearchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Search concepts of the New York Times by name.",
+	Long: `Search concepts of the New York Times by name.
+
+	Concept types include:
+		nytd_des, nytd_geo, nytd_org, nytd_per
+
+	Example usage:
+		gonyt concepts search -q obama
+		gonyt concepts search -q paris -t nytd_geo`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+		conceptType := nytapi.ConceptType(conceptsFlagType)
+
+		concepts, err := client.SearchConcepts(ctx, conceptsFlagQuery, conceptType)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printConcepts(concepts)
+	},
+}
+
+var conceptsFetchCmd = &cobra.Command{
+	Use:   "fetch <concept>",
+	Short: "Fetch a concept of the New York Times by type and name.",
+	Long: `Fetch a concept of the New York Times by type and name.
+
+	Concept types include:
+		nytd_des, nytd_geo, nytd_org, nytd_per
+
+	Fields include:
+		all, pages, ticker_symbol, links, taxonomy, combinations,
+		geocodes, article_list, scope_notes, search_api_query
+
+	Example usage:
+		gonyt concepts fetch -t nytd_per "Obama, Barack"
+		gonyt concepts fetch -t nytd_geo "Paris (France)" --fields links,geocodes,article_list`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+		conceptType := nytapi.ConceptType(conceptsFlagType)
+		fields := []nytapi.ConceptField{}
+		for _, field := range conceptsFlagFields {
+			fields = append(fields, nytapi.ConceptField(field))
+		}
+
+		concept, err := client.FetchConcept(ctx, conceptType, args[0], fields)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printConcept(concept)
+	},
+}
+
+var conceptsGraphCmd = &cobra.Command{
+	Use:   "graph <concept>",
+	Short: "Explore the relations of a concept of the New York Times as a graph.",
+	Long: `Explore the relations of a concept of the New York Times as a graph.
+
+	Starting from the given concept, ancestors, descendants and taxonomic relations are followed breadth first
+	up to the given depth, external links of every fetched concept are attached. Concepts are fetched one at a time,
+	paced by the rate limit if one is set, otherwise with a pause of --interval in between.
+
+	Formats include:
+		dot, json
+
+	Example usage:
+		gonyt concepts graph -t nytd_des "Baseball" --depth 2 > baseball.dot
+		gonyt concepts graph -t nytd_geo "Paris (France)" --format json --max 20`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+		graphQuery := nytapi.ConceptGraphQuery{
+			ConceptType:     nytapi.ConceptType(conceptsFlagType),
+			SpecificConcept: args[0],
+			MaxDepth:        conceptsGraphFlagDepth,
+			MaxConcepts:     conceptsGraphFlagMax,
+			Interval:        conceptsGraphFlagInterval,
+		}
+
+		graph, err := client.CrawlConceptGraph(ctx, graphQuery)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printConceptGraph(graph)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(conceptsCmd)
+	conceptsCmd.AddCommand(conceptsSearchCmd)
+	conceptsCmd.AddCommand(conceptsFetchCmd)
+	conceptsCmd.AddCommand(conceptsGraphCmd)
+
+	conceptsSearchCmd.Flags().StringVarP(&conceptsFlagQuery, "query", "q", "", "Name of the concepts to look for.")
+	conceptsSearchCmd.MarkFlagRequired("query")
+	conceptsSearchCmd.Flags().StringVarP(&conceptsFlagType, "type", "t", "", "Only return concepts of this type.")
+
+	conceptsFetchCmd.ValidArgsFunction = completeConcepts
+	conceptsFetchCmd.Flags().StringVarP(&conceptsFlagType, "type", "t", "", "Type of the concept to be fetched.")
+	conceptsFetchCmd.MarkFlagRequired("type")
+	conceptsFetchCmd.Flags().StringSliceVar(&conceptsFlagFields, "fields", nil, "Additional information to be fetched for the concept.")
+
+	conceptsGraphCmd.ValidArgsFunction = completeConcepts
+	conceptsGraphCmd.Flags().StringVarP(&conceptsFlagType, "type", "t", "", "Type of the concept to start from.")
+	conceptsGraphCmd.MarkFlagRequired("type")
+	conceptsGraphCmd.Flags().IntVar(&conceptsGraphFlagDepth, "depth", 1, "Number of relations to follow from the start concept.")
+	conceptsGraphCmd.Flags().IntVar(&conceptsGraphFlagMax, "max", nytapi.ConceptGraphDefaultMaxConcepts, "Maximum number of concepts to be fetched.")
+	conceptsGraphCmd.Flags().DurationVar(&conceptsGraphFlagInterval, "interval", nytapi.ConceptGraphDefaultInterval, "Pause between two requests without rate limit.")
+	conceptsGraphCmd.Flags().StringVar(&conceptsGraphFlagFormat, "format", "dot", "Output format of the graph.")
+}
+
+// Tag filters matching the concept types, as concept names equal the canonical tags of the 'TimesTags' API
+var conceptTagFilters = map[nytapi.ConceptType]nytapi.TagFilter{
+	nytapi.ConceptTypeDescriptor:   nytapi.TagFilterDescriptor,
+	nytapi.ConceptTypeGeographic:   nytapi.TagFilterGeographic,
+	nytapi.ConceptTypeOrganization: nytapi.TagFilterOrganization,
+	nytapi.ConceptTypePerson:       nytapi.TagFilterPerson,
+}
+
+// Completes the concept argument with canonical tags of the concept type given via CLI flag
+func completeConcepts(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	filter, ok := conceptTagFilters[nytapi.ConceptType(conceptsFlagType)]
+	if len(args) > 0 || !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return suggestTimesTags(filter, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+var geoFlagQuery string
+
+var geoCmd = &cobra.Command{
+	Use:   "geo",
+	Short: "Look up places known to the New York Times.",
+	Long: `Look up places known to the New York Times.
+
+	Places are matched by name, including the geo facets articles are tagged with.
+
+	Example usage:
+		gonyt geo -q Brooklyn
+		gonyt geo -q "Paris (France)"`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		places, err := client.FetchGeo(ctx, geoFlagQuery)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printPlaces(places)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(geoCmd)
+
+	geoCmd.Flags().StringVarP(&geoFlagQuery, "query", "q", "", "Name of the places to look for.")
+	geoCmd.MarkFlagRequired("query")
+	geoCmd.RegisterFlagCompletionFunc("query", completeTimesTags(nytapi.TagFilterGeographic))
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var keysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "Report the usage of your API keys.",
+	Long: `Report the usage of your API keys.
+
+	Several API keys can be given via --apikey or as APIKEY in the config file, separated by commas.
+	Requests are sent with the first key until it exceeds its quota, then the next key is used.
+	Keys are identified by a fingerprint, daily usage is tracked as long as a daily budget is set.
+	As every run starts afresh, only the daily usage shared by all runs is reported.
+
+	Example usage:
+		gonyt keys --daily-budget 500
+		gonyt keys -a key1,key2 --json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		usage, err := client.KeyUsage()
+		if err != nil {
+			fmt.Println("Error reading API key usage!", err)
+			return
+		}
+
+		printKeyUsage(&usage)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(keysCmd)
+}
+
+// Usage of an API key as reported by the keys command, limited to the daily usage persisted across runs,
+// as the other counters of a key usage only cover the requests of the running process
+type persistedKeyUsage struct {
+	Fingerprint string `json:"fingerprint"`
+	DailyUsed   int    `json:"daily_used"`
+	DailyBudget int    `json:"daily_budget"`
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var lookupFlagPlaces bool
+
+var lookupCmd = &cobra.Command{
+	Use:   "lookup <url>",
+	Short: "Look up a New York Times article by its URL.",
+	Long: `Look up a New York Times article by its URL.
+
+	Query parameters such as tracking codes are removed from the URL before the lookup.
+	With --places the geo facets of the article are resolved into places including their coordinates.
+
+	Example usage:
+		gonyt lookup https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html
+		gonyt lookup https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html --places`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		article, err := client.FetchWireByURL(ctx, args[0])
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printArticleDetails(article)
+
+		if lookupFlagPlaces {
+			places, err := client.ResolveGeoFacets(ctx, article.GeoFacet)
+			if err != nil {
+				fmt.Println("Error calling New York Times API!", err)
+				return
+			}
+
+			printResolvedPlaces(article.GeoFacet, places)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(lookupCmd)
+
+	lookupCmd.Flags().BoolVar(&lookupFlagPlaces, "places", false, "Resolve the geo facets of the article into places.")
+}
@@ -10,6 +10,7 @@ import (
 
 var mostPopularFlagCategory string
 var mostPopularFlagPeriod int
+var mostPopularFlagShareType string
 
 // mostpopularCmd represents the mostpopular command
 var mostpopularCmd = &cobra.Command{
@@ -23,9 +24,13 @@ var mostpopularCmd = &cobra.Command{
 	Time periods (in days) include:
 		1, 7, 30
 
+	Share types, only valid with the shared category, include:
+		facebook
+
 	Example usage:
 		gonyt mostpopular -c emailed -p 7
 		gonyt mostpopular -c viewed -p 30
+		gonyt mostpopular -c shared -p 1 --share-type facebook
 	`,
 	Run: func(cmd *cobra.Command, args []string) {
 		client, err := newCLIClient()
@@ -36,8 +41,19 @@ var mostpopularCmd = &cobra.Command{
 		ctx := context.Background()
 		category := nytapi.MostPopularCategory(mostPopularFlagCategory)
 		period := nytapi.MostPopularPeriod(mostPopularFlagPeriod)
+		shareType := nytapi.MostPopularShareType(mostPopularFlagShareType)
 
-		articles, err := client.FetchMostPopularArticles(ctx, category, period)
+		if cmd.Flags().Changed("share-type") && category != nytapi.Shared {
+			fmt.Println("Error calling New York Times API!", fmt.Errorf("share type is only valid with category %v", nytapi.Shared))
+			return
+		}
+
+		var articles *[]nytapi.PopularArticle
+		if category == nytapi.Shared {
+			articles, err = client.FetchMostShared(ctx, period, shareType)
+		} else {
+			articles, err = client.FetchMostPopularArticles(ctx, category, period)
+		}
 		if err != nil {
 			fmt.Println("Error calling New York Times API!", err)
 			return
@@ -54,4 +70,5 @@ func init() {
 	mostpopularCmd.MarkFlagRequired("category")
 	mostpopularCmd.Flags().IntVarP(&mostPopularFlagPeriod, "period", "p", 1, "Most popular articles time period to be fetched.")
 	mostpopularCmd.MarkFlagRequired("period")
+	mostpopularCmd.Flags().StringVar(&mostPopularFlagShareType, "share-type", "", "Share type of the shared category to be fetched.")
 }
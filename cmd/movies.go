@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+var moviesFlagQuery string
+var moviesFlagReviewer string
+var moviesFlagCriticsPick bool
+var moviesFlagOpeningDate string
+var moviesFlagPublicationDate string
+var moviesFlagOrder string
+var moviesFlagOffset int
+
+const moviesDateFormat = "2006-01-02"
+
+var moviesCmd = &cobra.Command{
+	Use:   "movies",
+	Short: "Fetch movie reviews and critics from the New York Times.",
+	Long: `Fetch movie reviews and critics from the New York Times.
+
+	Reviews are searched by 'gonyt movies search', the critics' picks
+	are shown by 'gonyt movies picks' and the critics themselves by 'gonyt movies critics'.`,
+}
+
+var moviesSearchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Search movie reviews of the New York Times.",
+	Long: `Search movie reviews of the New York Times.
+
+	Orders include:
+		by-opening-date, by-publication-date, by-title
+
+	Dates are given either as a single day YYYY-MM-DD or as a range YYYY-MM-DD:YYYY-MM-DD.
+	Results are delivered in pages of 20, the offset has to be a multiple of 20.
+
+	Example usage:
+		gonyt movies search -q "lebowski"
+		gonyt movies search --reviewer "A. O. Scott" --critics-pick
+		gonyt movies search --opening 2020-01-01:2020-12-31 --order by-title --offset 20`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		reviewQuery, err := newMovieReviewQuery()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		reviews, hasMore, err := client.SearchMovieReviews(ctx, *reviewQuery)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printMovieReviews(reviews, hasMore)
+	},
+}
+
+var moviesPicksCmd = &cobra.Command{
+	Use:   "picks",
+	Short: "Fetch the critics' picks among movie reviews of the New York Times.",
+	Long: `Fetch the critics' picks among movie reviews of the New York Times.
+
+	Orders include:
+		by-opening-date, by-publication-date, by-title
+
+	Results are delivered in pages of 20, the offset has to be a multiple of 20.
+
+	Example usage:
+		gonyt movies picks
+		gonyt movies picks --order by-opening-date --offset 40`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		reviews, hasMore, err := client.FetchCriticsPicks(ctx, nytapi.MovieReviewOrder(moviesFlagOrder), moviesFlagOffset)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printMovieReviews(reviews, hasMore)
+	},
+}
+
+var moviesCriticsCmd = &cobra.Command{
+	Use:   "critics [reviewer]",
+	Short: "Fetch movie critics of the New York Times.",
+	Long: `Fetch movie critics of the New York Times.
+
+	The reviewer is either one of all, full-time, part-time or the name of a single critic.
+	Without a reviewer all critics are fetched.
+
+	Example usage:
+		gonyt movies critics
+		gonyt movies critics full-time
+		gonyt movies critics "A. O. Scott"`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		reviewer := nytapi.AllCritics
+		if len(args) > 0 {
+			reviewer = args[0]
+		}
+
+		critics, err := client.FetchCritics(ctx, reviewer)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printCritics(critics)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(moviesCmd)
+	moviesCmd.AddCommand(moviesSearchCmd)
+	moviesCmd.AddCommand(moviesPicksCmd)
+	moviesCmd.AddCommand(moviesCriticsCmd)
+
+	moviesSearchCmd.Flags().StringVarP(&moviesFlagQuery, "query", "q", "", "Search term to look for in movie titles and reviews.")
+	moviesSearchCmd.Flags().StringVar(&moviesFlagReviewer, "reviewer", "", "Name of the critic who wrote the reviews.")
+	moviesSearchCmd.Flags().BoolVar(&moviesFlagCriticsPick, "critics-pick", false, "Only fetch reviews marked as critics' picks.")
+	moviesSearchCmd.Flags().StringVar(&moviesFlagOpeningDate, "opening", "", "Opening date (YYYY-MM-DD or YYYY-MM-DD:YYYY-MM-DD) of the movies.")
+	moviesSearchCmd.Flags().StringVar(&moviesFlagPublicationDate, "publication", "", "Publication date (YYYY-MM-DD or YYYY-MM-DD:YYYY-MM-DD) of the reviews.")
+	moviesSearchCmd.Flags().StringVar(&moviesFlagOrder, "order", "", "Order of results.")
+	moviesSearchCmd.Flags().IntVar(&moviesFlagOffset, "offset", 0, "Offset of the results to be fetched, a multiple of 20.")
+
+	moviesPicksCmd.Flags().StringVar(&moviesFlagOrder, "order", "", "Order of results.")
+	moviesPicksCmd.Flags().IntVar(&moviesFlagOffset, "offset", 0, "Offset of the results to be fetched, a multiple of 20.")
+}
+
+// Assembles a movie review query from the CLI flags
+func newMovieReviewQuery() (*nytapi.MovieReviewQuery, error) {
+	openingFrom, openingTo, err := parseMoviesDateRange(moviesFlagOpeningDate)
+	if err != nil {
+		return nil, err
+	}
+	publicationFrom, publicationTo, err := parseMoviesDateRange(moviesFlagPublicationDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nytapi.MovieReviewQuery{
+		Query:               moviesFlagQuery,
+		Reviewer:            moviesFlagReviewer,
+		CriticsPick:         moviesFlagCriticsPick,
+		OpeningDateFrom:     openingFrom,
+		OpeningDateTo:       openingTo,
+		PublicationDateFrom: publicationFrom,
+		PublicationDateTo:   publicationTo,
+		Order:               nytapi.MovieReviewOrder(moviesFlagOrder),
+		Offset:              moviesFlagOffset,
+	}, nil
+}
+
+// Parses a CLI supplied single day or date range, treating an empty value as unset
+func parseMoviesDateRange(value string) (time.Time, time.Time, error) {
+	if value == "" {
+		return time.Time{}, time.Time{}, nil
+	}
+
+	parts := strings.SplitN(value, ":", 2)
+	from, err := time.Parse(moviesDateFormat, parts[0])
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date %v, expected format YYYY-MM-DD or YYYY-MM-DD:YYYY-MM-DD", value)
+	}
+	if len(parts) == 1 {
+		return from, from, nil
+	}
+
+	to, err := time.Parse(moviesDateFormat, parts[1])
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date %v, expected format YYYY-MM-DD or YYYY-MM-DD:YYYY-MM-DD", value)
+	}
+	return from, to, nil
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var rawFlagParams []string
+
+var rawCmd = &cobra.Command{
+	Use:   "raw <path>",
+	Short: "Request any endpoint of the New York Times API and print the unparsed response.",
+	Long: `Request any endpoint of the New York Times API and print the unparsed response.
+
+	The path is given relative to https://api.nytimes.com/svc, query parameters are given
+	as key=value pairs and may be repeated. The API key is added just like for all other commands.
+	With --verbose the status and headers of the response are printed before the body.
+
+	Example usage:
+		gonyt raw books/v3/lists/names.json
+		gonyt raw news/v3/content/all/world.json -q limit=5 -q offset=20
+		gonyt raw search/v2/articlesearch.json -q q=election -q fq='section_name:("U.S.")'`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		params, err := parseRawParams(rawFlagParams)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		rawResponse, err := client.DoRaw(ctx, args[0], params)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printRawResponse(client, rawResponse)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(rawCmd)
+
+	rawCmd.Flags().StringArrayVarP(&rawFlagParams, "query", "q", nil, "Query parameter as key=value pair.")
+}
+
+// Parses the key=value pairs given via CLI flags into query parameters
+func parseRawParams(pairs []string) (url.Values, error) {
+	params := url.Values{}
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid query parameter %v, expected format key=value", pair)
+		}
+		params.Add(parts[0], parts[1])
+	}
+	return params, nil
+}
@@ -3,7 +3,13 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"html"
 	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -16,6 +22,11 @@ import (
 var flagVerbose bool
 var flagJSONOutput bool
 var flagApiKey string
+var flagRetries int
+var flagRateLimit int
+var flagDailyBudget int
+var flagFailFast bool
+var flagTimeout time.Duration
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -35,7 +46,12 @@ func init() {
 
 	rootCmd.PersistentFlags().BoolVarP(&flagVerbose, "verbose", "v", false, "Output verbose infos.")
 	rootCmd.PersistentFlags().BoolVarP(&flagJSONOutput, "json", "j", false, "Output in plain JSON instead of formatted overview.")
-	rootCmd.PersistentFlags().StringVarP(&flagApiKey, "apikey", "a", "", "Your key for the New York Times API.")
+	rootCmd.PersistentFlags().StringVarP(&flagApiKey, "apikey", "a", "", "Your key for the New York Times API, several keys separated by commas.")
+	rootCmd.PersistentFlags().IntVar(&flagRetries, "retries", 0, "Number of times a request is retried on temporary API errors, e.g. exceeded rate limits.")
+	rootCmd.PersistentFlags().IntVar(&flagRateLimit, "rate-limit", 0, "Maximum number of requests per minute, e.g. 5 matching the quota of an API key.")
+	rootCmd.PersistentFlags().IntVar(&flagDailyBudget, "daily-budget", 0, "Maximum number of requests per day, shared by all runs, e.g. 500 matching the quota of an API key.")
+	rootCmd.PersistentFlags().BoolVar(&flagFailFast, "fail-fast", false, "Fail instead of waiting when the rate limit is exceeded.")
+	rootCmd.PersistentFlags().DurationVar(&flagTimeout, "timeout", 30*time.Second, "Maximum duration of a request including retries and waiting for the rate limit, 0 disables it.")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -52,44 +68,109 @@ func initConfig() {
 
 	if err := viper.ReadInConfig(); err == nil {
 		if flagVerbose {
-			fmt.Println("Using config file:", viper.ConfigFileUsed())
+			fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
 		}
 	}
 }
 
-// Returns a client suitable for any CLI command
-func newCLIClient() (*nytapi.Client, error) {
-	apiKey, err := preferredApiKey()
+// Returns a client suitable for any CLI command, further options taking precedence over the defaults
+func newCLIClient(options ...nytapi.Option) (*nytapi.Client, error) {
+	apiKeys, err := preferredApiKeys()
 	if err != nil {
 		return nil, err
 	}
 	if flagVerbose {
-		fmt.Println("Using api key:", apiKey)
+		fingerprints := []string{}
+		for _, apiKey := range apiKeys {
+			fingerprints = append(fingerprints, nytapi.KeyFingerprint(apiKey))
+		}
+		fmt.Fprintln(os.Stderr, "Using api keys:", strings.Join(fingerprints, ", "))
+	}
+
+	// The timeout is applied per request rather than by the HTTP client, which would also cut off streamed bodies.
+	httpClient := http.Client{}
+
+	defaults := []nytapi.Option{
+		nytapi.WithAPIKeys(apiKeys[1:]...),
+		nytapi.WithRetries(flagRetries),
+		nytapi.WithDefaultTimeout(flagTimeout),
+	}
+	if baseURL := viper.GetString("BASEURL"); baseURL != "" {
+		if flagVerbose {
+			fmt.Fprintln(os.Stderr, "Using base url:", nytapi.RedactAPIKeys(baseURL, apiKeys...))
+		}
+		defaults = append(defaults, nytapi.WithBaseURL(baseURL))
 	}
 
-	httpClient := http.Client{
-		Timeout: 15 * time.Second,
+	rateLimit, err := preferredRateLimit()
+	if err != nil {
+		return nil, err
+	}
+	if rateLimit != nil {
+		if flagVerbose {
+			fmt.Fprintf(os.Stderr, "Using rate limit: %v requests per minute, %v requests per day\n", rateLimit.RequestsPerMinute, rateLimit.DailyBudget)
+		}
+		defaults = append(defaults, nytapi.WithRateLimit(*rateLimit))
 	}
 
-	client := nytapi.NewClient(&httpClient, *apiKey)
+	client := nytapi.NewClient(&httpClient, apiKeys[0], append(defaults, options...)...)
 	return &client, nil
 }
 
-// Returns the relevant API Key in order of preference:
+// Returns the rate limit in order of preference:
 // CLI supplied > config file
-func preferredApiKey() (*string, error) {
-	if flagApiKey != "" {
-		return &flagApiKey, nil
+// The daily counters are persisted next to the config file, one per API key, so that all runs share one budget.
+func preferredRateLimit() (*nytapi.RateLimit, error) {
+	requestsPerMinute := flagRateLimit
+	if requestsPerMinute == 0 {
+		requestsPerMinute = viper.GetInt("RATELIMIT")
+	}
+	dailyBudget := flagDailyBudget
+	if dailyBudget == 0 {
+		dailyBudget = viper.GetInt("DAILYBUDGET")
+	}
+	if requestsPerMinute <= 0 && dailyBudget <= 0 {
+		return nil, nil
 	}
 
-	configAPIKey := viper.GetString("APIKEY")
-	if configAPIKey != "" {
-		return &configAPIKey, nil
+	home, err := homedir.Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	return &nytapi.RateLimit{
+		RequestsPerMinute: requestsPerMinute,
+		DailyBudget:       dailyBudget,
+		FailFast:          flagFailFast,
+		StatePath:         filepath.Join(home, ".gonyt-usage.json"),
+	}, nil
+}
+
+// Returns the relevant API Keys in order of preference:
+// CLI supplied > config file
+// Several keys are given as comma separated list, they are used in turn whenever a key exceeds its quota.
+func preferredApiKeys() ([]string, error) {
+	if apiKeys := splitApiKeys(flagApiKey); len(apiKeys) > 0 {
+		return apiKeys, nil
+	}
+
+	if apiKeys := splitApiKeys(viper.GetString("APIKEY")); len(apiKeys) > 0 {
+		return apiKeys, nil
 	}
 
 	return nil, fmt.Errorf("no API key provided via CLI or config file")
 }
 
+func splitApiKeys(value string) []string {
+	apiKeys := []string{}
+	for _, apiKey := range strings.Split(value, ",") {
+		if apiKey = strings.TrimSpace(apiKey); apiKey != "" {
+			apiKeys = append(apiKeys, apiKey)
+		}
+	}
+	return apiKeys
+}
+
 // Handles general printing based on CLI flags
 func printArticles(articles *[]nytapi.Article, updateTime *time.Time) {
 	if flagJSONOutput {
@@ -129,6 +210,273 @@ func printBookReviews(bookReviews *[]nytapi.BookReview) {
 	}
 }
 
+// Handles general printing of search documents based on CLI flags
+func printSearchDocuments(documents *[]nytapi.SearchDocument, meta *nytapi.SearchMeta) {
+	if flagJSONOutput {
+		err := printJSONSearchDocuments(documents)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printSearchDocumentsCLI(documents, meta)
+	}
+}
+
+// Handles general printing of a single streamed search document based on CLI flags,
+// JSON output is written as one object per line
+func printStreamedSearchDocument(document *nytapi.SearchDocument) error {
+	if flagJSONOutput {
+		json, err := json.Marshal(document)
+		if err != nil {
+			return fmt.Errorf("failed to marshal to JSON")
+		}
+
+		fmt.Println(string(json))
+		return nil
+	}
+
+	printSearchDocumentCLI(document)
+	return nil
+}
+
+// Handles general printing of a bestseller list based on CLI flags
+func printBestsellerList(bestsellerList *nytapi.BestsellerList) {
+	if flagJSONOutput {
+		err := printJSONBestsellerList(bestsellerList)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printBestsellerListCLI(bestsellerList)
+	}
+}
+
+// Handles general printing of bestseller list names based on CLI flags
+func printBestsellerListNames(listNames *[]nytapi.BestsellerListName) {
+	if flagJSONOutput {
+		err := printJSONBestsellerListNames(listNames)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printBestsellerListNamesCLI(listNames)
+	}
+}
+
+// Handles general printing of a bestseller overview based on CLI flags
+func printBestsellerOverview(overview *nytapi.BestsellerOverview) {
+	if flagJSONOutput {
+		err := printJSONBestsellerOverview(overview)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printBestsellerOverviewCLI(overview)
+	}
+}
+
+// Handles general printing of a bestseller history based on CLI flags
+func printBestsellerHistory(history *[]nytapi.BestsellerHistory, numResults int) {
+	if flagJSONOutput {
+		err := printJSONBestsellerHistory(history)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printBestsellerHistoryCLI(history, numResults)
+	}
+}
+
+// Handles general printing of movie reviews based on CLI flags
+func printMovieReviews(reviews *[]nytapi.MovieReview, hasMore bool) {
+	if flagJSONOutput {
+		err := printJSONMovieReviews(reviews)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printMovieReviewsCLI(reviews, hasMore)
+	}
+}
+
+// Handles general printing of movie critics based on CLI flags
+func printCritics(critics *[]nytapi.Critic) {
+	if flagJSONOutput {
+		err := printJSONCritics(critics)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printCriticsCLI(critics)
+	}
+}
+
+// Handles general printing of wire articles based on CLI flags
+func printWireArticles(articles *[]nytapi.WireArticle) {
+	if flagJSONOutput {
+		err := printJSONWireArticles(articles)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printWireArticlesCLI(articles)
+	}
+}
+
+// Handles general printing of a single article with all its metadata based on CLI flags
+func printArticleDetails(article *nytapi.Article) {
+	if flagJSONOutput {
+		err := printJSONArticles(&[]nytapi.Article{*article})
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printArticleDetailsCLI(article)
+	}
+}
+
+// Handles general printing of concepts based on CLI flags
+func printConcepts(concepts *[]nytapi.Concept) {
+	if flagJSONOutput {
+		err := printJSONConcepts(concepts)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printConceptsCLI(concepts)
+	}
+}
+
+// Handles general printing of a single concept based on CLI flags
+func printConcept(concept *nytapi.Concept) {
+	if flagJSONOutput {
+		err := printJSONConcept(concept)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printConceptCLI(concept)
+	}
+}
+
+// Handles printing of a concept graph in the format given via CLI flags, JSON output taking precedence
+func printConceptGraph(graph *nytapi.ConceptGraph) {
+	if flagJSONOutput || conceptsGraphFlagFormat == "json" {
+		err := printJSONConceptGraph(graph)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+		}
+		return
+	}
+
+	if conceptsGraphFlagFormat != "dot" {
+		fmt.Println("Error printing graph! Unknown format:", conceptsGraphFlagFormat)
+		return
+	}
+	if err := graph.WriteDOT(os.Stdout); err != nil {
+		fmt.Println("Error printing graph!", err)
+	}
+}
+
+// Handles general printing of tag suggestions based on CLI flags
+func printTimesTags(tags *[]nytapi.TimesTag) {
+	if flagJSONOutput {
+		err := printJSONTimesTags(tags)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printTimesTagsCLI(tags)
+	}
+}
+
+// Handles general printing of API key usage based on CLI flags
+func printKeyUsage(usage *[]nytapi.KeyUsage) {
+	if flagJSONOutput {
+		err := printJSONKeyUsage(usage)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printKeyUsageCLI(usage)
+	}
+}
+
+// Handles general printing of a single streamed comment thread based on CLI flags,
+// JSON output is written as one object per line
+func printStreamedComment(comment *nytapi.Comment) error {
+	if flagJSONOutput {
+		json, err := json.Marshal(comment)
+		if err != nil {
+			return fmt.Errorf("failed to marshal to JSON")
+		}
+
+		fmt.Println(string(json))
+		return nil
+	}
+
+	printCommentsCLI([]nytapi.Comment{*comment}, 0)
+	return nil
+}
+
+// Handles general printing of places based on CLI flags
+func printPlaces(places *[]nytapi.Geo) {
+	if flagJSONOutput {
+		err := printJSONPlaces(places)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printPlacesCLI(places)
+	}
+}
+
+// Handles general printing of geo facets resolved into places based on CLI flags
+func printResolvedPlaces(facets []string, places map[string]nytapi.Geo) {
+	if flagJSONOutput {
+		err := printJSONResolvedPlaces(places)
+		if err != nil {
+			fmt.Println("Error printing JSON!", err)
+			return
+		}
+	} else {
+		printResolvedPlacesCLI(facets, places)
+	}
+}
+
+// Handles printing of a raw response, including status and headers in verbose mode
+// Headers are redacted by the client, since they may echo the request including the API key
+func printRawResponse(client *nytapi.Client, rawResponse *nytapi.RawResponse) {
+	if flagVerbose {
+		fmt.Println("Status:", rawResponse.StatusCode)
+		keys := []string{}
+		for key := range rawResponse.Header {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Println(key+":", client.Redact(strings.Join(rawResponse.Header[key], ", ")))
+		}
+		fmt.Println()
+	}
+
+	fmt.Println(string(rawResponse.Body))
+}
+
 // Handles printing of articles as JSON array
 func printJSONArticles(articles *[]nytapi.Article) error {
 	json, err := json.Marshal(articles)
@@ -162,6 +510,180 @@ func printJSONBookReviews(bookReviews *[]nytapi.BookReview) error {
 	return nil
 }
 
+// Handles printing of search documents as JSON array
+func printJSONSearchDocuments(documents *[]nytapi.SearchDocument) error {
+	json, err := json.Marshal(documents)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of a bestseller list as JSON object
+func printJSONBestsellerList(bestsellerList *nytapi.BestsellerList) error {
+	json, err := json.Marshal(bestsellerList)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of bestseller list names as JSON array
+func printJSONBestsellerListNames(listNames *[]nytapi.BestsellerListName) error {
+	json, err := json.Marshal(listNames)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of a bestseller overview as JSON object
+func printJSONBestsellerOverview(overview *nytapi.BestsellerOverview) error {
+	json, err := json.Marshal(overview)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of a bestseller history as JSON array
+func printJSONBestsellerHistory(history *[]nytapi.BestsellerHistory) error {
+	json, err := json.Marshal(history)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of movie reviews as JSON array
+func printJSONMovieReviews(reviews *[]nytapi.MovieReview) error {
+	json, err := json.Marshal(reviews)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of movie critics as JSON array
+func printJSONCritics(critics *[]nytapi.Critic) error {
+	json, err := json.Marshal(critics)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of wire articles as JSON array
+func printJSONWireArticles(articles *[]nytapi.WireArticle) error {
+	json, err := json.Marshal(articles)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of concepts as JSON array
+func printJSONConcepts(concepts *[]nytapi.Concept) error {
+	json, err := json.Marshal(concepts)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of a single concept as JSON object
+func printJSONConcept(concept *nytapi.Concept) error {
+	json, err := json.Marshal(concept)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of a concept graph as JSON object
+func printJSONConceptGraph(graph *nytapi.ConceptGraph) error {
+	json, err := json.Marshal(graph)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of tag suggestions as JSON array
+func printJSONTimesTags(tags *[]nytapi.TimesTag) error {
+	json, err := json.Marshal(tags)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of API key usage as JSON array
+func printJSONKeyUsage(usage *[]nytapi.KeyUsage) error {
+	persisted := []persistedKeyUsage{}
+	for _, keyUsage := range *usage {
+		persisted = append(persisted, persistedKeyUsage{
+			Fingerprint: keyUsage.Fingerprint,
+			DailyUsed:   keyUsage.DailyUsed,
+			DailyBudget: keyUsage.DailyBudget,
+		})
+	}
+
+	json, err := json.Marshal(persisted)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of places as JSON array
+func printJSONPlaces(places *[]nytapi.Geo) error {
+	json, err := json.Marshal(places)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
+// Handles printing of resolved places as JSON object keyed by geo facet
+func printJSONResolvedPlaces(places map[string]nytapi.Geo) error {
+	json, err := json.Marshal(places)
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON")
+	}
+
+	fmt.Println(string(json))
+	return nil
+}
+
 // Handles opinionated printing of articles
 func printArticlesCLI(articles *[]nytapi.Article, updateTime *time.Time) {
 	fmt.Println("Last update:", updateTime)
@@ -197,3 +719,283 @@ func printBookReviewsCLI(bookReviews *[]nytapi.BookReview) {
 		fmt.Println("\t", bookReview.URL)
 	}
 }
+
+// Handles opinionated printing of search documents
+func printSearchDocumentsCLI(documents *[]nytapi.SearchDocument, meta *nytapi.SearchMeta) {
+	if meta != nil {
+		fmt.Println("Hits:", meta.Hits, "Offset:", meta.Offset)
+		fmt.Println()
+	}
+
+	for _, document := range *documents {
+		printSearchDocumentCLI(&document)
+	}
+}
+
+// Handles opinionated printing of a single search document
+func printSearchDocumentCLI(document *nytapi.SearchDocument) {
+	fmt.Println(document.Headline.Main)
+	if document.Abstract != "" {
+		fmt.Println("\t", document.Abstract)
+	}
+	if document.Byline.Original != "" {
+		fmt.Println("\t", document.Byline.Original)
+	}
+	fmt.Println("\t", document.WebURL)
+}
+
+// Handles opinionated printing of a bestseller list
+func printBestsellerListCLI(bestsellerList *nytapi.BestsellerList) {
+	fmt.Println(bestsellerList.DisplayName, "-", bestsellerList.PublishedDate)
+	fmt.Println()
+
+	printBestsellersCLI(&bestsellerList.Books)
+}
+
+// Handles opinionated printing of bestsellers
+func printBestsellersCLI(bestsellers *[]nytapi.Bestseller) {
+	for _, book := range *bestsellers {
+		fmt.Printf("%d. %v  -  %v\n", book.Rank, book.Author, book.Title)
+		if book.Description != "" {
+			fmt.Println("\t", book.Description)
+		}
+		fmt.Println("\t", "Weeks on list:", book.WeeksOnList, "Rank last week:", book.RankLastWeek)
+		if book.BookReviewLink != "" {
+			fmt.Println("\t", book.BookReviewLink)
+		}
+		if book.AmazonProductURL != "" {
+			fmt.Println("\t", book.AmazonProductURL)
+		}
+	}
+}
+
+// Handles opinionated printing of bestseller list names
+func printBestsellerListNamesCLI(listNames *[]nytapi.BestsellerListName) {
+	for _, listName := range *listNames {
+		fmt.Println(listName.DisplayName)
+		fmt.Println("\t", listName.ListNameEncoded)
+		fmt.Println("\t", listName.OldestPublishedDate, "-", listName.NewestPublishedDate, "("+strings.ToLower(listName.Updated)+")")
+	}
+}
+
+// Handles opinionated printing of a bestseller overview, grouped by list
+func printBestsellerOverviewCLI(overview *nytapi.BestsellerOverview) {
+	fmt.Println("Published:", overview.PublishedDate)
+
+	for _, list := range overview.Lists {
+		fmt.Println()
+		fmt.Println(list.DisplayName)
+		fmt.Println()
+		printBestsellersCLI(&list.Books)
+	}
+}
+
+// Handles opinionated printing of a bestseller history
+func printBestsellerHistoryCLI(history *[]nytapi.BestsellerHistory, numResults int) {
+	fmt.Println("Results:", numResults)
+	fmt.Println()
+
+	for _, book := range *history {
+		fmt.Println(book.Author, " - ", book.Title)
+		if book.Description != "" {
+			fmt.Println("\t", book.Description)
+		}
+		for _, rank := range book.RanksHistory {
+			fmt.Printf("\t %v  %v  #%d (%d weeks on list)\n", rank.PublishedDate, rank.DisplayName, rank.Rank, rank.WeeksOnList)
+		}
+		for _, review := range book.Reviews {
+			if review.BookReviewLink != "" {
+				fmt.Println("\t", review.BookReviewLink)
+			}
+		}
+	}
+}
+
+// Handles opinionated printing of movie reviews
+func printMovieReviewsCLI(reviews *[]nytapi.MovieReview, hasMore bool) {
+	for _, review := range *reviews {
+		title := review.DisplayTitle
+		if review.CriticsPick == 1 {
+			title += " (Critics' pick)"
+		}
+		fmt.Println(title)
+		if review.SummaryShort != "" {
+			fmt.Println("\t", review.SummaryShort)
+		}
+		fmt.Println("\t", review.Byline, " - ", review.PublicationDate)
+		fmt.Println("\t", review.Link.URL)
+	}
+
+	if hasMore {
+		fmt.Println()
+		fmt.Println("More reviews available, use --offset to fetch them.")
+	}
+}
+
+// Handles opinionated printing of movie critics
+func printCriticsCLI(critics *[]nytapi.Critic) {
+	for _, critic := range *critics {
+		fmt.Println(critic.DisplayName, "("+critic.Status+")")
+		if critic.Bio != "" {
+			fmt.Println("\t", critic.Bio)
+		}
+	}
+}
+
+// Handles opinionated printing of wire articles
+func printWireArticlesCLI(articles *[]nytapi.WireArticle) {
+	for _, article := range *articles {
+		fmt.Println(article.Title)
+		fmt.Println("\t", article.PublishedDate.Format(time.RFC822), " - ", article.Section)
+		if article.Abstract != "" {
+			fmt.Println("\t", article.Abstract)
+		}
+		fmt.Println("\t", article.Url)
+	}
+}
+
+// Handles opinionated printing of a single article with all its metadata
+func printArticleDetailsCLI(article *nytapi.Article) {
+	printArticlesCLI(&[]nytapi.Article{*article}, &article.UpdatedDate)
+
+	if article.Byline != "" {
+		fmt.Println("\t", article.Byline)
+	}
+	fmt.Println("\t", "Published:", article.PublishedDate.Format(time.RFC822), " - ", article.Section)
+	for _, facets := range []struct {
+		name   string
+		values []string
+	}{
+		{"Topics", article.DesFacet},
+		{"Organizations", article.OrgFacet},
+		{"People", article.PerFacet},
+		{"Places", article.GeoFacet},
+	} {
+		if len(facets.values) > 0 {
+			fmt.Println("\t", facets.name+":", strings.Join(facets.values, "; "))
+		}
+	}
+	for _, multimedia := range article.Multimedia {
+		fmt.Println("\t", multimedia.Format+":", multimedia.Url)
+	}
+}
+
+// Handles opinionated printing of concepts
+func printConceptsCLI(concepts *[]nytapi.Concept) {
+	for _, concept := range *concepts {
+		fmt.Println(concept.ConceptName, "("+concept.ConceptType+")")
+		if concept.Vernacular != "" {
+			fmt.Println("\t", concept.Vernacular)
+		}
+	}
+}
+
+// Handles opinionated printing of a single concept including all requested details
+func printConceptCLI(concept *nytapi.Concept) {
+	fmt.Println(concept.ConceptName, "("+concept.ConceptType+")")
+	if concept.SearchAPIQuery != "" {
+		fmt.Println("\t", "Search:", concept.SearchAPIQuery)
+	}
+	for _, ancestor := range concept.Ancestors {
+		fmt.Println("\t", "Ancestor:", ancestor.ConceptName, "("+ancestor.ConceptType+")")
+	}
+	for _, descendant := range concept.Descendants {
+		fmt.Println("\t", "Descendant:", descendant.ConceptName, "("+descendant.ConceptType+")")
+	}
+	for _, link := range concept.Links {
+		fmt.Println("\t", "Link:", link.Link, "("+link.LinkType+")")
+	}
+	for _, geocode := range concept.Geocodes {
+		fmt.Println("\t", "Geocode:", geocode.Name, geocode.CountryName, geocode.Latitude, geocode.Longitude)
+	}
+
+	if concept.ArticleList != nil {
+		fmt.Println()
+		fmt.Println("Articles:", concept.ArticleList.Total)
+		fmt.Println()
+		for _, article := range concept.ArticleList.Results {
+			fmt.Println(article.Title)
+			fmt.Println("\t", article.Date, " - ", article.Byline)
+			fmt.Println("\t", article.URL)
+		}
+	}
+}
+
+// Handles opinionated printing of tag suggestions
+func printTimesTagsCLI(tags *[]nytapi.TimesTag) {
+	for _, tag := range *tags {
+		if tag.Type != "" {
+			fmt.Println(tag.Name, "("+tag.Type+")")
+		} else {
+			fmt.Println(tag.Name)
+		}
+	}
+}
+
+// Handles opinionated printing of API key usage, one key per line identified by its fingerprint
+func printKeyUsageCLI(usage *[]nytapi.KeyUsage) {
+	for i, keyUsage := range *usage {
+		daily := "daily usage not tracked without daily budget"
+		if keyUsage.DailyBudget > 0 {
+			daily = fmt.Sprintf("%v of %v requests today", keyUsage.DailyUsed, keyUsage.DailyBudget)
+		}
+		fmt.Printf("%v. Key %v: %v\n", i+1, keyUsage.Fingerprint, daily)
+	}
+}
+
+// Handles opinionated printing of comment threads, indenting replies below the comment they answer
+func printCommentsCLI(comments []nytapi.Comment, depth int) {
+	indent := strings.Repeat("\t", depth)
+	for _, comment := range comments {
+		author := comment.UserDisplayName
+		if comment.UserLocation != "" {
+			author += " (" + comment.UserLocation + ")"
+		}
+		details := fmt.Sprintf("%v recommendations", comment.Recommendations)
+		if seconds, err := strconv.ParseInt(comment.CreateDate, 10, 64); err == nil {
+			details = time.Unix(seconds, 0).Format(time.RFC822) + " - " + details
+		}
+		if comment.EditorsSelection {
+			details += " - NYT Pick"
+		}
+
+		fmt.Println(indent+author, " - ", details)
+		for _, line := range strings.Split(strings.ReplaceAll(comment.CommentBody, "<br/>", "\n"), "\n") {
+			if line = strings.TrimSpace(html.UnescapeString(line)); line != "" {
+				fmt.Println(indent+"\t", line)
+			}
+		}
+		fmt.Println()
+
+		printCommentsCLI(comment.Replies, depth+1)
+	}
+}
+
+// Handles opinionated printing of places
+func printPlacesCLI(places *[]nytapi.Geo) {
+	for _, place := range *places {
+		printPlaceCLI(place.Name, place)
+	}
+}
+
+// Handles opinionated printing of geo facets along with the places they were resolved into
+func printResolvedPlacesCLI(facets []string, places map[string]nytapi.Geo) {
+	fmt.Println()
+	for _, facet := range facets {
+		if place, ok := places[facet]; ok {
+			printPlaceCLI(facet, place)
+		} else {
+			fmt.Println(facet)
+			fmt.Println("\t", "No place found")
+		}
+	}
+}
+
+// Handles opinionated printing of a single place under the given name
+func printPlaceCLI(name string, place nytapi.Geo) {
+	fmt.Println(name, "("+place.FeatureClass+")")
+	fmt.Println("\t", place.Latitude, place.Longitude, " - ", place.CountryName)
+	if place.Population.Int64() > 0 {
+		fmt.Println("\t", "Population:", place.Population)
+	}
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+var rssFlagBaseURL string
+
+var rssCmd = &cobra.Command{
+	Use:   "rss <feed>",
+	Short: "Fetch a public RSS feed of the New York Times.",
+	Long: `Fetch a public RSS feed of the New York Times.
+
+	RSS feeds cover sections the APIs do not, and require no API key. Feeds are fetched from
+	https://rss.nytimes.com/services/xml/rss/nyt unless another base URL is given via --base-url
+	or as RSSBASEURL in the config file.
+
+	Feeds include:
+		HomePage, World, US, Politics, NYRegion, Business, Technology, Science, Health,
+		Sports, Arts, Books, Movies, Theater, Travel, Magazine, RealEstate, Upshot
+
+	Example usage:
+		gonyt rss Technology
+		gonyt rss World --json
+		gonyt rss World --base-url http://localhost:8080/feeds`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := newRSSClient()
+		ctx := context.Background()
+
+		articles, updateTime, err := client.FetchRSSFeed(ctx, args[0])
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printArticles(articles, updateTime)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(rssCmd)
+
+	rssCmd.Flags().StringVar(&rssFlagBaseURL, "base-url", "", "Base URL the feed is fetched from.")
+}
+
+// Provides a client for RSS feeds, which are public and hence need no API key,
+// the base URL given via CLI flag taking precedence over the config file
+func newRSSClient() *nytapi.Client {
+	httpClient := http.Client{}
+	client := nytapi.NewClient(&httpClient, "", nytapi.WithRetries(flagRetries), nytapi.WithDefaultTimeout(flagTimeout))
+
+	baseURL := rssFlagBaseURL
+	if baseURL == "" {
+		baseURL = viper.GetString("RSSBASEURL")
+	}
+	if baseURL != "" {
+		if flagVerbose {
+			fmt.Fprintln(os.Stderr, "Using rss base url:", baseURL)
+		}
+		client.UseRSSBaseURL(baseURL)
+	}
+
+	return &client
+}
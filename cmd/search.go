@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/thorstenpfister/gonyt/nytapi"
+	"github.com/thorstenpfister/gonyt/nytapi/fq"
+)
+
+var searchFlagQuery string
+var searchFlagFilterQuery string
+var searchFlagBeginDate string
+var searchFlagEndDate string
+var searchFlagSort string
+var searchFlagPage int
+var searchFlagFields []string
+var searchFlagAll bool
+var searchFlagMax int
+var searchFlagPersons []string
+var searchFlagOrganizations []string
+var searchFlagLocations []string
+var searchFlagSubjects []string
+
+const searchDateFormat = "20060102"
+
+var searchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Search articles of the New York Times.",
+	Long: `Search articles of the New York Times.
+
+	Sort orders include:
+		newest, oldest, relevance
+
+	Dates are given in the format YYYYMMDD.
+
+	Facet flags narrow results down to articles tagged with the given canonical tags.
+	Repeating a facet flag matches articles tagged with any of its values, values are
+	completed to canonical tags via the shell completion of gonyt.
+
+	With --all articles are printed while the pages are received, with --json every
+	article is printed as a JSON object on its own line.
+
+	Example usage:
+		gonyt search -q "election"
+		gonyt search -q "mars rover" --sort newest --begin 20210101 --end 20210630
+		gonyt search --fq 'section_name:("Sports")' -p 2
+		gonyt search --person "Obama, Barack" --geo "Kenya"
+		gonyt search -q "olympics" --begin 20210701 --end 20210831 --all --max 500`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+
+		searchQuery, err := newSearchQuery()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		if searchFlagAll {
+			if err := printAllArticles(ctx, client, *searchQuery); err != nil {
+				fmt.Println("Error calling New York Times API!", err)
+			}
+			return
+		}
+
+		documents, meta, err := client.SearchArticles(ctx, *searchQuery)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printSearchDocuments(documents, meta)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(searchCmd)
+
+	searchCmd.Flags().StringVarP(&searchFlagQuery, "query", "q", "", "Search term to look for in articles.")
+	searchCmd.Flags().StringVar(&searchFlagFilterQuery, "fq", "", "Filter query in Lucene syntax to narrow down results.")
+	searchCmd.Flags().StringVar(&searchFlagBeginDate, "begin", "", "Earliest publication date (YYYYMMDD) of results.")
+	searchCmd.Flags().StringVar(&searchFlagEndDate, "end", "", "Latest publication date (YYYYMMDD) of results.")
+	searchCmd.Flags().StringVar(&searchFlagSort, "sort", "", "Sort order of results.")
+	searchCmd.Flags().IntVarP(&searchFlagPage, "page", "p", 0, "Page of results to be fetched, 10 results per page.")
+	searchCmd.Flags().StringSliceVar(&searchFlagFields, "fields", nil, "Limit the fields returned per article.")
+	searchCmd.Flags().BoolVar(&searchFlagAll, "all", false, "Fetch all pages of results instead of a single one.")
+	searchCmd.Flags().IntVar(&searchFlagMax, "max", 0, "Maximum number of results fetched with --all, 0 for no limit.")
+	searchCmd.Flags().StringArrayVar(&searchFlagPersons, "person", nil, "Only return articles tagged with this person.")
+	searchCmd.RegisterFlagCompletionFunc("person", completeTimesTags(nytapi.TagFilterPerson))
+	searchCmd.Flags().StringArrayVar(&searchFlagOrganizations, "org", nil, "Only return articles tagged with this organization.")
+	searchCmd.RegisterFlagCompletionFunc("org", completeTimesTags(nytapi.TagFilterOrganization))
+	searchCmd.Flags().StringArrayVar(&searchFlagLocations, "geo", nil, "Only return articles tagged with this location.")
+	searchCmd.RegisterFlagCompletionFunc("geo", completeTimesTags(nytapi.TagFilterGeographic))
+	searchCmd.Flags().StringArrayVar(&searchFlagSubjects, "subject", nil, "Only return articles tagged with this subject.")
+	searchCmd.RegisterFlagCompletionFunc("subject", completeTimesTags(nytapi.TagFilterDescriptor))
+}
+
+// Assembles an article search query from the CLI flags
+func newSearchQuery() (*nytapi.ArticleSearchQuery, error) {
+	beginDate, err := parseSearchDate(searchFlagBeginDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseSearchDate(searchFlagEndDate)
+	if err != nil {
+		return nil, err
+	}
+	filterQuery, err := newSearchFilterQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	return &nytapi.ArticleSearchQuery{
+		Query:       searchFlagQuery,
+		FilterQuery: filterQuery,
+		BeginDate:   beginDate,
+		EndDate:     endDate,
+		Sort:        nytapi.ArticleSearchSort(searchFlagSort),
+		Page:        searchFlagPage,
+		Fields:      searchFlagFields,
+	}, nil
+}
+
+// Combines the filter query given via CLI flag with a clause for each facet flag
+func newSearchFilterQuery() (string, error) {
+	facets := []fq.Clause{}
+	for _, facet := range []struct {
+		field  fq.Field
+		values []string
+	}{
+		{fq.Persons, searchFlagPersons},
+		{fq.Organizations, searchFlagOrganizations},
+		{fq.Glocations, searchFlagLocations},
+		{fq.Subject, searchFlagSubjects},
+	} {
+		if len(facet.values) > 0 {
+			facets = append(facets, fq.Values(facet.field, facet.values...))
+		}
+	}
+	if len(facets) == 0 {
+		return searchFlagFilterQuery, nil
+	}
+
+	filterQuery, err := fq.Build(fq.And(facets...))
+	if err != nil {
+		return "", err
+	}
+	if searchFlagFilterQuery != "" {
+		filterQuery = fmt.Sprintf("(%v) AND %v", searchFlagFilterQuery, filterQuery)
+	}
+	return filterQuery, nil
+}
+
+// Parses a CLI supplied date, treating an empty value as unset
+func parseSearchDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse(searchDateFormat, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %v, expected format YYYYMMDD", value)
+	}
+	return date, nil
+}
+
+// Prints all articles of a search while they are received, stopping early once the maximum given via CLI flag
+// is reached. Articles received before an error remain printed, the error is returned afterwards.
+func printAllArticles(ctx context.Context, client *nytapi.Client, searchQuery nytapi.ArticleSearchQuery) error {
+	printed := 0
+
+	it := client.IterateArticles(ctx, searchQuery)
+	for it.Next() {
+		document := it.Document()
+		if err := printStreamedSearchDocument(&document); err != nil {
+			return err
+		}
+		printed++
+		if searchFlagMax > 0 && printed >= searchFlagMax {
+			break
+		}
+	}
+	return it.Err()
+}
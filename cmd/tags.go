@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+var tagsFlagQuery string
+var tagsFlagFilters []string
+var tagsFlagMax int
+
+// Number of suggestions offered when completing a facet value on the command line.
+const tagsCompletionMax = 20
+
+var tagsCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "Suggest canonical tags of the New York Times.",
+	Long: `Suggest canonical tags of the New York Times.
+
+	Tags are the canonical names of the people, places, organizations and descriptors
+	articles are tagged with, as expected by facet filters such as 'gonyt search --person'.
+
+	Filters include:
+		Des, Geo, Org, Per
+
+	Example usage:
+		gonyt tags -q obam
+		gonyt tags -q brook --filter Geo,Org --max 5`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+		filters := []nytapi.TagFilter{}
+		for _, filter := range tagsFlagFilters {
+			filters = append(filters, nytapi.TagFilter(filter))
+		}
+
+		tags, err := client.SuggestTags(ctx, tagsFlagQuery, filters, tagsFlagMax)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printTimesTags(tags)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tagsCmd)
+
+	tagsCmd.Flags().StringVarP(&tagsFlagQuery, "query", "q", "", "Beginning of the tags to look for.")
+	tagsCmd.MarkFlagRequired("query")
+	tagsCmd.Flags().StringSliceVar(&tagsFlagFilters, "filter", nil, "Only suggest tags of these types.")
+	tagsCmd.Flags().IntVar(&tagsFlagMax, "max", 0, "Maximum number of tags to be suggested, 0 for the API default.")
+}
+
+// Provides a completion function suggesting canonical tags of the given type for a facet value,
+// completing to nothing whenever no API key is available or the API cannot be reached
+func completeTimesTags(filter nytapi.TagFilter) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return suggestTimesTags(filter, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func suggestTimesTags(filter nytapi.TagFilter, toComplete string) []string {
+	completions := []string{}
+	if toComplete == "" {
+		return completions
+	}
+
+	client, err := newCLIClient()
+	if err != nil {
+		return completions
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tags, err := client.SuggestTags(ctx, toComplete, []nytapi.TagFilter{filter}, tagsCompletionMax)
+	if err != nil {
+		return completions
+	}
+	names := []string{}
+	for _, tag := range *tags {
+		names = append(names, tag.Name)
+	}
+	return matchCompletionPrefix(names, toComplete)
+}
+
+// Keeps the suggested names starting with the typed prefix regardless of case, e.g. "obam" keeps "Obama, Barack".
+// Names are returned unchanged, as facet filters expect the canonical spelling.
+func matchCompletionPrefix(names []string, toComplete string) []string {
+	prefix := strings.ToLower(toComplete)
+	completions := []string{}
+	for _, name := range names {
+		if strings.HasPrefix(strings.ToLower(name), prefix) {
+			completions = append(completions, name)
+		}
+	}
+	return completions
+}
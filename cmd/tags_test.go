@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_matchCompletionPrefix_WithValues(t *testing.T) {
+	names := []string{"Obama, Barack", "Obama, Michelle", "Obamacare (Law)", "Biden, Joseph R Jr", "Éire", "Ölkrise"}
+
+	var cases = []struct {
+		toComplete string
+		expected   []string
+	}{
+		{"Obam", []string{"Obama, Barack", "Obama, Michelle", "Obamacare (Law)"}},
+		{"obam", []string{"Obama, Barack", "Obama, Michelle", "Obamacare (Law)"}},
+		{"OBAMA, b", []string{"Obama, Barack"}},
+		{"bid", []string{"Biden, Joseph R Jr"}},
+		{"éi", []string{"Éire"}},
+		{"ö", []string{"Ölkrise"}},
+		{"trump", []string{}},
+	}
+
+	for _, tt := range cases {
+		assert.Equal(t, tt.expected, matchCompletionPrefix(names, tt.toComplete), tt.toComplete)
+	}
+}
@@ -3,34 +3,40 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/thorstenpfister/gonyt/nytapi"
 )
 
 var topStoriesFlagSection string
+var topStoriesFlagLive bool
 
-var topstoriesCmd = &cobra.Command{
-	Use:   "topstories",
-	Short: "Fetch top stories from a New York Times section.",
-	Long: `Fetch top stories from a New York Times section.
+const topStoriesLong = `Fetch top stories from a New York Times section.
 
 	Sections include: 	
-		arts, automobiles, books, business, fashion, food, 
-		health, home, insider, magazine, movies, nyregion, 
-		obituaries, opinion, politics, realestate, science, 
-		sports, sundayreview, technology, theater, t-magazine, 
-		travel, upshot, us, world
+%v
+
+	Once an API key is available, the sections published in the section list of the API are listed.
+	With --live sections are also accepted as spelled in the section list, e.g. u.s. for us,
+	which takes an additional request for sections unknown to gonyt.
 
 	Example usage:
 		gonyt topstories -s opinion		
-		gonyt topstories -s magazine`,
+		gonyt topstories -s magazine`
+
+var topstoriesCmd = &cobra.Command{
+	Use:   "topstories",
+	Short: "Fetch top stories from a New York Times section.",
+	Long:  fmt.Sprintf(topStoriesLong, formatTopStoriesSections(nytapi.TopStoriesSections())),
 	Run: func(cmd *cobra.Command, args []string) {
 		client, err := newCLIClient()
 		if err != nil {
 			fmt.Println("Error calling New York Times API!", err)
 			return
 		}
+		client.UseLiveSections(topStoriesFlagLive)
 		ctx := context.Background()
 		section := nytapi.TopStoriesSection(topStoriesFlagSection)
 
@@ -49,4 +55,55 @@ func init() {
 
 	topstoriesCmd.Flags().StringVarP(&topStoriesFlagSection, "section", "s", "", "Top stories section to be fetched.")
 	topstoriesCmd.MarkFlagRequired("section")
+	topstoriesCmd.RegisterFlagCompletionFunc("section", completeTopStoriesSections)
+	topstoriesCmd.Flags().BoolVar(&topStoriesFlagLive, "live", false, "Accept sections as spelled in the section list of the API.")
+
+	defaultHelp := topstoriesCmd.HelpFunc()
+	topstoriesCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		// Help is printed before cobra initializes, the API key of the config file is needed for the discovery though.
+		initConfig()
+		cmd.Long = fmt.Sprintf(topStoriesLong, formatTopStoriesSections(discoverTopStoriesSections()))
+		defaultHelp(cmd, args)
+	})
+}
+
+// Returns the known sections published in the section list of the API, falling back to the known sections
+// whenever no API key is available or the API cannot be reached
+func discoverTopStoriesSections() []nytapi.TopStoriesSection {
+	client, err := newCLIClient()
+	if err != nil {
+		return nytapi.TopStoriesSections()
+	}
+	client.UseLiveSections(true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sections, _ := client.TopStoriesSections(ctx)
+	return sections
+}
+
+// Completes top stories sections with the discovered sections
+func completeTopStoriesSections(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	completions := []string{}
+	for _, section := range discoverTopStoriesSections() {
+		if strings.HasPrefix(string(section), toComplete) {
+			completions = append(completions, string(section))
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// Formats sections as indented, comma separated lines for the help text
+func formatTopStoriesSections(sections []nytapi.TopStoriesSection) string {
+	lines := []string{}
+	line := []string{}
+	for i, section := range sections {
+		line = append(line, string(section))
+		if len(line) == 7 || i == len(sections)-1 {
+			lines = append(lines, "\t\t"+strings.Join(line, ", "))
+			line = nil
+		}
+	}
+	return strings.Join(lines, ",\n")
 }
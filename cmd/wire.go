@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+var wireFlagSource string
+var wireFlagSection string
+var wireFlagLimit int
+var wireFlagOffset int
+var wireFlagPeriod int
+
+var wireCmd = &cobra.Command{
+	Use:   "wire",
+	Short: "Fetch the latest articles from the New York Times wire.",
+	Long: `Fetch the latest articles from the New York Times wire.
+
+	Sources include:
+		all, nyt, inyt
+
+	Sections are given by their display name in lower case, e.g. world, u.s., business,
+	without a section all sections are fetched.
+	The period limits the articles to those published within the last hours, up to 720.
+
+	Example usage:
+		gonyt wire
+		gonyt wire --source nyt -s world --limit 50
+		gonyt wire -s business --period 24`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newCLIClient()
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+		ctx := context.Background()
+		source := nytapi.WireSource(wireFlagSource)
+		timePeriod := time.Duration(wireFlagPeriod) * time.Hour
+
+		articles, err := client.FetchWireContentWithin(ctx, source, wireFlagSection, timePeriod, wireFlagLimit, wireFlagOffset)
+		if err != nil {
+			fmt.Println("Error calling New York Times API!", err)
+			return
+		}
+
+		printWireArticles(articles)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(wireCmd)
+
+	wireCmd.Flags().StringVar(&wireFlagSource, "source", string(nytapi.AllSources), "Source of the articles to be fetched.")
+	wireCmd.Flags().StringVarP(&wireFlagSection, "section", "s", nytapi.AllSections, "Section of the articles to be fetched.")
+	wireCmd.RegisterFlagCompletionFunc("section", completeWireSections)
+	wireCmd.Flags().IntVarP(&wireFlagLimit, "limit", "l", 20, "Number of articles to be fetched, up to 500.")
+	wireCmd.Flags().IntVar(&wireFlagOffset, "offset", 0, "Offset of the articles to be fetched.")
+	wireCmd.Flags().IntVar(&wireFlagPeriod, "period", 0, "Only fetch articles published within the last hours.")
+}
+
+// Completes wire sections with the section list currently published by the API
+func completeWireSections(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	client, err := newCLIClient()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	sections, err := client.FetchSectionList(context.Background())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	completions := []string{}
+	if strings.HasPrefix(nytapi.AllSections, toComplete) {
+		completions = append(completions, nytapi.AllSections)
+	}
+	for _, section := range *sections {
+		if strings.HasPrefix(section.Section, toComplete) {
+			completions = append(completions, section.Section+"\t"+section.DisplayName)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
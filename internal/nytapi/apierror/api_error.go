@@ -3,6 +3,9 @@ package apierror
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type errorType int
@@ -34,22 +37,27 @@ const (
 )
 
 // APIError captures basic information for any API error.
+// RetryAfter holds the delay requested by the server via the Retry-After header, zero if there was none.
 type APIError struct {
 	Type           errorType
 	HTTPStatusCode int
+	RetryAfter     time.Duration
 }
 
 // NewAPIError creates a new concrete apierror.APIError from a given *http.response.
 func NewAPIError(res *http.Response) APIError {
 	errorWithType := func(errType errorType) APIError {
 		var statusCode = 0
+		var retryAfter time.Duration
 		if res != nil {
 			statusCode = res.StatusCode
+			retryAfter = parseRetryAfter(res.Header.Get("Retry-After"))
 		}
 
 		return APIError{
 			Type:           errType,
 			HTTPStatusCode: statusCode,
+			RetryAfter:     retryAfter,
 		}
 	}
 
@@ -101,6 +109,15 @@ func NewAPIError(res *http.Response) APIError {
 	return errorWithType(UnknownError)
 }
 
+// Retryable reports whether the request which caused the error is safe to retry after waiting a short amount of time.
+func (err APIError) Retryable() bool {
+	switch err.Type {
+	case TooManyRequestsError, ServerError, BadGatewayError, ServiceUnavailableError, GatewayTimeoutError:
+		return true
+	}
+	return false
+}
+
 func (err APIError) Error() string {
 	return fmt.Sprintf("%v %v", err.baseMessage(), err.baseInformation())
 }
@@ -153,3 +170,25 @@ func (err APIError) baseMessage() string {
 
 	return "Unknown error. Please get in touch with your support representative."
 }
+
+// Parses a Retry-After header, which either holds a number of seconds or an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
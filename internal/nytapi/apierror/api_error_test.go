@@ -3,6 +3,7 @@ package apierror_test
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
@@ -90,3 +91,59 @@ func Test_APIError_ShouldHaveErrorMessage(t *testing.T) {
 		}
 	}
 }
+
+func Test_APIError_ShouldBeRetryable_WithValue(t *testing.T) {
+	var cases = []struct {
+		statusCode        int
+		expectedRetryable bool
+	}{
+		{1, false},
+		{400, false},
+		{401, false},
+		{403, false},
+		{404, false},
+		{408, false},
+		{429, true},
+		{500, true},
+		{502, true},
+		{503, true},
+		{504, true},
+	}
+
+	for _, tt := range cases {
+		sut := apierror.NewAPIError(&http.Response{StatusCode: tt.statusCode})
+
+		assert.Equal(t, tt.expectedRetryable, sut.Retryable(), tt.statusCode)
+	}
+}
+
+func Test_APIError_ShouldReflectRetryAfter_WithValue(t *testing.T) {
+	var cases = []struct {
+		retryAfter string
+		expected   time.Duration
+	}{
+		{"", 0},
+		{"120", 2 * time.Minute},
+		{" 3 ", 3 * time.Second},
+		{"-1", 0},
+		{"soon", 0},
+		{"Wed, 21 Oct 2015 07:28:00 GMT", 0},
+	}
+
+	for _, tt := range cases {
+		res := http.Response{StatusCode: 429, Header: http.Header{}}
+		res.Header.Set("Retry-After", tt.retryAfter)
+		sut := apierror.NewAPIError(&res)
+
+		assert.Equal(t, tt.expected, sut.RetryAfter, tt.retryAfter)
+	}
+}
+
+func Test_APIError_ShouldReflectRetryAfterDate_WithValue(t *testing.T) {
+	res := http.Response{StatusCode: 503, Header: http.Header{}}
+	res.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+
+	sut := apierror.NewAPIError(&res)
+
+	assert.InDelta(t, float64(time.Minute), float64(sut.RetryAfter), float64(2*time.Second))
+}
@@ -0,0 +1,131 @@
+package nytapi
+
+import "encoding/json"
+
+// BestsellerList as delivered by the New York Times 'Books' API.
+type BestsellerList struct {
+	ListName                 string       `json:"list_name,omitempty"`
+	ListNameEncoded          string       `json:"list_name_encoded,omitempty"`
+	DisplayName              string       `json:"display_name,omitempty"`
+	BestsellersDate          string       `json:"bestsellers_date,omitempty"`
+	PublishedDate            string       `json:"published_date,omitempty"`
+	PublishedDateDescription string       `json:"published_date_description,omitempty"`
+	NextPublishedDate        string       `json:"next_published_date,omitempty"`
+	PreviousPublishedDate    string       `json:"previous_published_date,omitempty"`
+	NormalListEndsAt         int          `json:"normal_list_ends_at,omitempty"`
+	Updated                  string       `json:"updated,omitempty"`
+	Books                    []Bestseller `json:"books,omitempty"`
+}
+
+// Bestseller is a book ranked on a bestseller list of the New York Times 'Books' API.
+type Bestseller struct {
+	Rank               int         `json:"rank,omitempty"`
+	RankLastWeek       int         `json:"rank_last_week,omitempty"`
+	WeeksOnList        int         `json:"weeks_on_list,omitempty"`
+	Asterisk           int         `json:"asterisk,omitempty"`
+	Dagger             int         `json:"dagger,omitempty"`
+	PrimaryIsbn10      string      `json:"primary_isbn10,omitempty"`
+	PrimaryIsbn13      string      `json:"primary_isbn13,omitempty"`
+	Publisher          string      `json:"publisher,omitempty"`
+	Description        string      `json:"description,omitempty"`
+	Price              json.Number `json:"price,omitempty"`
+	Title              string      `json:"title,omitempty"`
+	Author             string      `json:"author,omitempty"`
+	Contributor        string      `json:"contributor,omitempty"`
+	ContributorNote    string      `json:"contributor_note,omitempty"`
+	BookImage          string      `json:"book_image,omitempty"`
+	BookImageWidth     int         `json:"book_image_width,omitempty"`
+	BookImageHeight    int         `json:"book_image_height,omitempty"`
+	AmazonProductURL   string      `json:"amazon_product_url,omitempty"`
+	AgeGroup           string      `json:"age_group,omitempty"`
+	BookReviewLink     string      `json:"book_review_link,omitempty"`
+	FirstChapterLink   string      `json:"first_chapter_link,omitempty"`
+	SundayReviewLink   string      `json:"sunday_review_link,omitempty"`
+	ArticleChapterLink string      `json:"article_chapter_link,omitempty"`
+	Isbns              []Isbn      `json:"isbns,omitempty"`
+	BuyLinks           []BuyLink   `json:"buy_links,omitempty"`
+	BookURI            string      `json:"book_uri,omitempty"`
+}
+
+// Isbn pair of a book from the New York Times 'Books' API.
+type Isbn struct {
+	Isbn10 string `json:"isbn10,omitempty"`
+	Isbn13 string `json:"isbn13,omitempty"`
+}
+
+// BuyLink to a retailer of a book from the New York Times 'Books' API.
+type BuyLink struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// BestsellerListName describes a bestseller list as delivered by the New York Times 'Books' API.
+type BestsellerListName struct {
+	ListName            string `json:"list_name,omitempty"`
+	DisplayName         string `json:"display_name,omitempty"`
+	ListNameEncoded     string `json:"list_name_encoded,omitempty"`
+	OldestPublishedDate string `json:"oldest_published_date,omitempty"`
+	NewestPublishedDate string `json:"newest_published_date,omitempty"`
+	Updated             string `json:"updated,omitempty"`
+}
+
+// BestsellerOverview of all bestseller lists of a given date as delivered by the New York Times 'Books' API.
+type BestsellerOverview struct {
+	BestsellersDate          string                   `json:"bestsellers_date,omitempty"`
+	PublishedDate            string                   `json:"published_date,omitempty"`
+	PublishedDateDescription string                   `json:"published_date_description,omitempty"`
+	PreviousPublishedDate    string                   `json:"previous_published_date,omitempty"`
+	NextPublishedDate        string                   `json:"next_published_date,omitempty"`
+	Lists                    []BestsellerOverviewList `json:"lists,omitempty"`
+}
+
+// BestsellerOverviewList is a bestseller list with its top books within a BestsellerOverview.
+type BestsellerOverviewList struct {
+	ListID          int          `json:"list_id,omitempty"`
+	ListName        string       `json:"list_name,omitempty"`
+	ListNameEncoded string       `json:"list_name_encoded,omitempty"`
+	DisplayName     string       `json:"display_name,omitempty"`
+	Updated         string       `json:"updated,omitempty"`
+	ListImage       string       `json:"list_image,omitempty"`
+	ListImageWidth  int          `json:"list_image_width,omitempty"`
+	ListImageHeight int          `json:"list_image_height,omitempty"`
+	Books           []Bestseller `json:"books,omitempty"`
+}
+
+// BestsellerHistory of a book across all bestseller lists as delivered by the New York Times 'Books' API.
+type BestsellerHistory struct {
+	Title           string              `json:"title,omitempty"`
+	Description     string              `json:"description,omitempty"`
+	Contributor     string              `json:"contributor,omitempty"`
+	Author          string              `json:"author,omitempty"`
+	ContributorNote string              `json:"contributor_note,omitempty"`
+	Price           json.Number         `json:"price,omitempty"`
+	AgeGroup        string              `json:"age_group,omitempty"`
+	Publisher       string              `json:"publisher,omitempty"`
+	Isbns           []Isbn              `json:"isbns,omitempty"`
+	RanksHistory    []BestsellerRank    `json:"ranks_history,omitempty"`
+	Reviews         []BestsellerReviews `json:"reviews,omitempty"`
+}
+
+// BestsellerRank of a book on a single bestseller list for a given week from the New York Times 'Books' API.
+type BestsellerRank struct {
+	PrimaryIsbn10   string `json:"primary_isbn10,omitempty"`
+	PrimaryIsbn13   string `json:"primary_isbn13,omitempty"`
+	Rank            int    `json:"rank,omitempty"`
+	ListName        string `json:"list_name,omitempty"`
+	DisplayName     string `json:"display_name,omitempty"`
+	PublishedDate   string `json:"published_date,omitempty"`
+	BestsellersDate string `json:"bestsellers_date,omitempty"`
+	WeeksOnList     int    `json:"weeks_on_list,omitempty"`
+	RanksLastWeek   int    `json:"ranks_last_week,omitempty"`
+	Asterisk        int    `json:"asterisk,omitempty"`
+	Dagger          int    `json:"dagger,omitempty"`
+}
+
+// BestsellerReviews links to reviews of a book from the New York Times 'Books' API.
+type BestsellerReviews struct {
+	BookReviewLink     string `json:"book_review_link,omitempty"`
+	FirstChapterLink   string `json:"first_chapter_link,omitempty"`
+	SundayReviewLink   string `json:"sunday_review_link,omitempty"`
+	ArticleChapterLink string `json:"article_chapter_link,omitempty"`
+}
@@ -0,0 +1,42 @@
+package nytapi
+
+// Comment of a reader on an article as delivered by the New York Times 'Community' API.
+// Replies hold the answers to the comment, which in turn may hold replies of their own.
+type Comment struct {
+	CommentID             int       `json:"commentID,omitempty"`
+	Status                string    `json:"status,omitempty"`
+	CommentSequence       int       `json:"commentSequence,omitempty"`
+	UserID                int       `json:"userID,omitempty"`
+	UserDisplayName       string    `json:"userDisplayName,omitempty"`
+	UserLocation          string    `json:"userLocation,omitempty"`
+	UserTitle             string    `json:"userTitle,omitempty"`
+	PicURL                string    `json:"picURL,omitempty"`
+	CommentTitle          string    `json:"commentTitle,omitempty"`
+	CommentBody           string    `json:"commentBody,omitempty"`
+	CreateDate            string    `json:"createDate,omitempty"`
+	UpdateDate            string    `json:"updateDate,omitempty"`
+	ApproveDate           string    `json:"approveDate,omitempty"`
+	Recommendations       int       `json:"recommendations"`
+	ReplyCount            int       `json:"replyCount"`
+	Replies               []Comment `json:"replies,omitempty"`
+	EditorsSelection      bool      `json:"editorsSelection"`
+	ParentID              int       `json:"parentID,omitempty"`
+	ParentUserDisplayName string    `json:"parentUserDisplayName,omitempty"`
+	Depth                 int       `json:"depth,omitempty"`
+	CommentType           string    `json:"commentType,omitempty"`
+	Trusted               int       `json:"trusted,omitempty"`
+	PermID                string    `json:"permID,omitempty"`
+	IsAnonymous           bool      `json:"isAnonymous,omitempty"`
+}
+
+// CommentsMeta describes the totals of a 'Community' API response, used for paging through comments.
+type CommentsMeta struct {
+	TotalCommentsFound          int `json:"totalCommentsFound"`
+	TotalCommentsReturned       int `json:"totalCommentsReturned"`
+	TotalParentCommentsFound    int `json:"totalParentCommentsFound"`
+	TotalParentCommentsReturned int `json:"totalParentCommentsReturned"`
+	TotalReplyCommentsFound     int `json:"totalReplyCommentsFound"`
+	TotalReplyCommentsReturned  int `json:"totalReplyCommentsReturned"`
+	TotalEditorsSelectionFound  int `json:"totalEditorsSelectionFound"`
+	DepthLimit                  int `json:"depthLimit,omitempty"`
+}
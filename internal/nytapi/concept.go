@@ -0,0 +1,111 @@
+package nytapi
+
+// Concept as delivered by the New York Times 'Semantic' API.
+// Ancestors and descendants are always delivered, links, taxonomic relations, geocodes and the article list
+// only when requested via fields.
+type Concept struct {
+	ConceptID      int64                `json:"concept_id,omitempty"`
+	ConceptName    string               `json:"concept_name,omitempty"`
+	ConceptStatus  string               `json:"concept_status,omitempty"`
+	ConceptType    string               `json:"concept_type,omitempty"`
+	ConceptCreated string               `json:"concept_created,omitempty"`
+	ConceptUpdated string               `json:"concept_updated,omitempty"`
+	IsTimesTag     int                  `json:"is_times_tag,omitempty"`
+	IsSearchable   int                  `json:"is_searchable,omitempty"`
+	Vernacular     string               `json:"vernacular,omitempty"`
+	TickerSymbol   string               `json:"ticker_symbol,omitempty"`
+	SearchAPIQuery string               `json:"search_api_query,omitempty"`
+	Links          []ConceptLink        `json:"links,omitempty"`
+	Ancestors      []ConceptRelation    `json:"ancestors,omitempty"`
+	Descendants    []ConceptRelation    `json:"descendants,omitempty"`
+	Taxonomy       []ConceptTaxonomy    `json:"taxonomy,omitempty"`
+	Combinations   []ConceptCombination `json:"combinations,omitempty"`
+	Geocodes       []ConceptGeocode     `json:"geocodes,omitempty"`
+	ScopeNotes     []ConceptScopeNote   `json:"scope_notes,omitempty"`
+	ArticleList    *ConceptArticleList  `json:"article_list,omitempty"`
+}
+
+// ConceptLink to a resource outside of the New York Times describing the same concept.
+type ConceptLink struct {
+	ConceptID   int64  `json:"concept_id,omitempty"`
+	LinkID      int64  `json:"link_id,omitempty"`
+	Relation    string `json:"relation,omitempty"`
+	Link        string `json:"link,omitempty"`
+	LinkType    string `json:"link_type,omitempty"`
+	MappingType string `json:"mapping_type,omitempty"`
+}
+
+// ConceptRelation references an ancestor or descendant of a concept.
+type ConceptRelation struct {
+	ConceptID     int64  `json:"concept_id,omitempty"`
+	ConceptName   string `json:"concept_name,omitempty"`
+	ConceptType   string `json:"concept_type,omitempty"`
+	ConceptStatus string `json:"concept_status,omitempty"`
+	IsTimesTag    int    `json:"is_times_tag,omitempty"`
+}
+
+// ConceptTaxonomy describes the taxonomic relation between two concepts.
+type ConceptTaxonomy struct {
+	SourceConceptID   int64  `json:"source_concept_id,omitempty"`
+	SourceConceptName string `json:"source_concept_name,omitempty"`
+	SourceConceptType string `json:"source_concept_type,omitempty"`
+	TargetConceptID   int64  `json:"target_concept_id,omitempty"`
+	TargetConceptName string `json:"target_concept_name,omitempty"`
+	TargetConceptType string `json:"target_concept_type,omitempty"`
+	TaxonomicRelation string `json:"taxonomic_relation,omitempty"`
+}
+
+// ConceptCombination of two concepts which are used together.
+type ConceptCombination struct {
+	CombinationSourceConceptID   int64  `json:"combination_source_concept_id,omitempty"`
+	CombinationSourceConceptName string `json:"combination_source_concept_name,omitempty"`
+	CombinationSourceConceptType string `json:"combination_source_concept_type,omitempty"`
+	CombinationTargetConceptID   int64  `json:"combination_target_concept_id,omitempty"`
+	CombinationTargetConceptName string `json:"combination_target_concept_name,omitempty"`
+	CombinationTargetConceptType string `json:"combination_target_concept_type,omitempty"`
+	CombinationNote              string `json:"combination_note,omitempty"`
+}
+
+// ConceptGeocode locates a geographic concept.
+type ConceptGeocode struct {
+	GeocodeID    int64  `json:"geocode_id,omitempty"`
+	ConceptID    int64  `json:"concept_id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Latitude     Number `json:"latitude,omitempty"`
+	Longitude    Number `json:"longitude,omitempty"`
+	Elevation    Number `json:"elevation,omitempty"`
+	Population   Number `json:"population,omitempty"`
+	CountryCode  string `json:"country_code,omitempty"`
+	CountryName  string `json:"country_name,omitempty"`
+	AdminCode1   string `json:"admin_code1,omitempty"`
+	AdminName1   string `json:"admin_name1,omitempty"`
+	AdminCode2   string `json:"admin_code2,omitempty"`
+	AdminName2   string `json:"admin_name2,omitempty"`
+	FeatureClass string `json:"feature_class,omitempty"`
+	FeatureCode  string `json:"feature_code,omitempty"`
+	TimeZoneID   string `json:"time_zone_id,omitempty"`
+}
+
+// ConceptScopeNote explains the usage of a concept.
+type ConceptScopeNote struct {
+	ScopeNoteID   int64  `json:"scope_note_id,omitempty"`
+	ScopeNote     string `json:"scope_note,omitempty"`
+	ScopeNoteName string `json:"scope_note_name,omitempty"`
+	ScopeNoteType string `json:"scope_note_type,omitempty"`
+}
+
+// ConceptArticleList holds the latest articles tagged with a concept.
+type ConceptArticleList struct {
+	Total   int              `json:"total,omitempty"`
+	Results []ConceptArticle `json:"results,omitempty"`
+}
+
+// ConceptArticle tagged with a concept, as delivered by the New York Times 'Semantic' API.
+type ConceptArticle struct {
+	Title    string              `json:"title,omitempty"`
+	Body     string              `json:"body,omitempty"`
+	Byline   string              `json:"byline,omitempty"`
+	Date     string              `json:"date,omitempty"`
+	URL      string              `json:"url,omitempty"`
+	Concepts map[string][]string `json:"concepts,omitempty"`
+}
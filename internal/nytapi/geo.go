@@ -0,0 +1,83 @@
+package nytapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Geo is a geographic place as delivered by the New York Times 'Geographic' API.
+// Coordinates, elevation and population are delivered as numbers or strings, just like those of a ConceptGeocode.
+type Geo struct {
+	ConceptID    int64  `json:"concept_id,omitempty"`
+	ConceptName  string `json:"concept_name,omitempty"`
+	GeocodeID    int64  `json:"geocode_id,omitempty"`
+	GeonameID    int64  `json:"geoname_id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Latitude     Number `json:"latitude"`
+	Longitude    Number `json:"longitude"`
+	Elevation    Number `json:"elevation,omitempty"`
+	FeatureClass string `json:"feature_class,omitempty"`
+	FeatureCode  string `json:"feature_code,omitempty"`
+	CountryCode  string `json:"country_code,omitempty"`
+	CountryName  string `json:"country_name,omitempty"`
+	AdminCode1   string `json:"admin_code1,omitempty"`
+	AdminName1   string `json:"admin_name1,omitempty"`
+	AdminCode2   string `json:"admin_code2,omitempty"`
+	AdminName2   string `json:"admin_name2,omitempty"`
+	Population   Number `json:"population,omitempty"`
+	TimeZoneID   string `json:"time_zone_id,omitempty"`
+}
+
+// Number of a place, such as its latitude or population. The 'Geographic' and 'Semantic' APIs deliver these
+// either as numbers or as strings, an empty string denoting an unknown value.
+type Number string
+
+// UnmarshalJSON decodes a number given as JSON number or as string, accepting an empty string or null for no value.
+func (n *Number) UnmarshalJSON(data []byte) error {
+	value := strings.TrimSpace(string(data))
+	if value == "null" || isEmptyJSONString(data) {
+		*n = ""
+		return nil
+	}
+	if strings.HasPrefix(value, `"`) {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		value = strings.TrimSpace(value)
+	}
+
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return fmt.Errorf("invalid number: %v", value)
+	}
+	*n = Number(value)
+	return nil
+}
+
+// MarshalJSON encodes the number as JSON number, or as null if unknown.
+func (n Number) MarshalJSON() ([]byte, error) {
+	if n == "" {
+		return []byte("null"), nil
+	}
+	return []byte(n), nil
+}
+
+// Float64 returns the number as float64, 0 if unknown.
+func (n Number) Float64() float64 {
+	value, _ := strconv.ParseFloat(string(n), 64)
+	return value
+}
+
+// Int64 returns the number as int64, truncating any fraction, 0 if unknown.
+func (n Number) Int64() int64 {
+	if value, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+		return value
+	}
+	return int64(n.Float64())
+}
+
+// String returns the number as delivered, an empty string if unknown.
+func (n Number) String() string {
+	return string(n)
+}
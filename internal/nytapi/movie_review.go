@@ -0,0 +1,52 @@
+package nytapi
+
+// MovieReview as delivered by the New York Times 'Movie reviews' API.
+type MovieReview struct {
+	DisplayTitle    string                 `json:"display_title,omitempty"`
+	MpaaRating      string                 `json:"mpaa_rating,omitempty"`
+	CriticsPick     int                    `json:"critics_pick,omitempty"`
+	Byline          string                 `json:"byline,omitempty"`
+	Headline        string                 `json:"headline,omitempty"`
+	SummaryShort    string                 `json:"summary_short,omitempty"`
+	PublicationDate string                 `json:"publication_date,omitempty"`
+	OpeningDate     string                 `json:"opening_date,omitempty"`
+	DateUpdated     string                 `json:"date_updated,omitempty"`
+	Link            MovieReviewLink        `json:"link,omitempty"`
+	Multimedia      *MovieReviewMultimedia `json:"multimedia,omitempty"`
+}
+
+// MovieReviewLink to the full review on the New York Times website.
+type MovieReviewLink struct {
+	Type              string `json:"type,omitempty"`
+	URL               string `json:"url,omitempty"`
+	SuggestedLinkText string `json:"suggested_link_text,omitempty"`
+}
+
+// MovieReviewMultimedia asset belonging to a movie review from the New York Times 'Movie reviews' API.
+type MovieReviewMultimedia struct {
+	Type   string `json:"type,omitempty"`
+	Src    string `json:"src,omitempty"`
+	Height int    `json:"height,omitempty"`
+	Width  int    `json:"width,omitempty"`
+}
+
+// Critic as delivered by the New York Times 'Movie reviews' API.
+type Critic struct {
+	DisplayName string            `json:"display_name,omitempty"`
+	SortName    string            `json:"sort_name,omitempty"`
+	Status      string            `json:"status,omitempty"`
+	Bio         string            `json:"bio,omitempty"`
+	SeoName     string            `json:"seo_name,omitempty"`
+	Multimedia  *CriticMultimedia `json:"multimedia,omitempty"`
+}
+
+// CriticMultimedia belonging to a critic from the New York Times 'Movie reviews' API.
+type CriticMultimedia struct {
+	Resource struct {
+		Type   string `json:"type,omitempty"`
+		Src    string `json:"src,omitempty"`
+		Height int    `json:"height,omitempty"`
+		Width  int    `json:"width,omitempty"`
+		Credit string `json:"credit,omitempty"`
+	} `json:"resource,omitempty"`
+}
@@ -1,8 +1,12 @@
 package port
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
 )
@@ -12,17 +16,113 @@ type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// RequestHook is called with every request right before its execution, e.g. to add headers or to log it.
+// Hooks are called before the API key is added, so that requests can be logged without revealing it.
+// Returning an error aborts the request.
+type RequestHook func(req *http.Request) error
+
 // HTTPPort represents a port holding a http client satisfying the HTTPClient interface,
-// a BaseURL for all requests and a Host used in request headers.
+// a BaseURL for all requests and the APIKeys to authorize them with.
+//
+// UserAgent, DefaultTimeout, RequestHooks and RetryPolicy are optional and applied to every request executed via Do().
+// The DefaultTimeout only applies to requests whose context has no deadline of its own and covers all retries.
+// Every attempt takes a key of the APIKeys, adding it as api-key to the query, without keys requests are sent as is.
 type HTTPPort struct {
-	HTTPClient HTTPClient
-	BaseURL    string
-	APIKey     string
+	HTTPClient     HTTPClient
+	BaseURL        string
+	APIKeys        *KeyPool
+	UserAgent      string
+	DefaultTimeout time.Duration
+	RequestHooks   []RequestHook
+	RetryPolicy    RetryPolicy
 }
 
 // Do intiates the execution of a http.Request and results in a http.Response in case of success
-// or in an error specifying the source of failure.
+// or in an error specifying the source of failure. API keys are scrubbed from the error.
 func (p *HTTPPort) Do(req *http.Request) (*http.Response, error) {
+	res, err := p.do(req)
+	if err != nil {
+		return nil, p.redact(err)
+	}
+	return res, nil
+}
+
+func (p *HTTPPort) do(req *http.Request) (*http.Response, error) {
+	cancel := func() {}
+	if _, hasDeadline := req.Context().Deadline(); !hasDeadline && p.DefaultTimeout > 0 {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(req.Context(), p.DefaultTimeout)
+		req = req.WithContext(ctx)
+	}
+
+	if p.UserAgent != "" {
+		req.Header.Set("User-Agent", p.UserAgent)
+	}
+
+	for attempt := 1; ; {
+		for _, hook := range p.RequestHooks {
+			if err := hook(req); err != nil {
+				cancel()
+				return nil, fmt.Errorf("The request hook failed with error: %v", err)
+			}
+		}
+
+		// The key is added to a copy of the request only, so that hooks of later attempts do not get to see it.
+		authorizedReq := req
+		var key *poolKey
+		if p.APIKeys != nil && p.APIKeys.Len() > 0 {
+			authorizedReq = req.Clone(req.Context())
+			var err error
+			if key, err = p.APIKeys.authorize(authorizedReq); err != nil {
+				cancel()
+				return nil, err
+			}
+		}
+
+		res, err := p.execute(authorizedReq)
+		if err == nil {
+			// The timeout has to outlive Do(), as the body is read by the caller afterwards.
+			if res.Body == nil {
+				cancel()
+			} else {
+				res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+			}
+			return res, nil
+		}
+
+		// A key exceeding its quota is replaced by the next one right away, which is not a retry.
+		if key != nil && p.APIKeys.report(key, err) && p.rewind(req) {
+			continue
+		}
+
+		delay, retry := p.RetryPolicy.delay(attempt, err)
+		if !retry || !p.rewind(req) || !waitForRetry(req.Context(), delay) {
+			cancel()
+			return nil, err
+		}
+		attempt++
+	}
+}
+
+// Scrubs the API keys from errors, e.g. a *url.Error holding the request URL. Errors typed by this library
+// never contain a key and are returned as they are, so that they can still be told apart.
+func (p *HTTPPort) redact(err error) error {
+	switch err.(type) {
+	case apierror.APIError, RateLimitError:
+		return err
+	}
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return err
+	}
+
+	message := p.APIKeys.Redact(err.Error())
+	if message == err.Error() {
+		return err
+	}
+	return errors.New(message)
+}
+
+func (p *HTTPPort) execute(req *http.Request) (*http.Response, error) {
 	res, err := p.HTTPClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("The HTTP request execution failed with error: %v", err)
@@ -30,15 +130,47 @@ func (p *HTTPPort) Do(req *http.Request) (*http.Response, error) {
 
 	if !p.successfulRequest(res) {
 		apiError := apierror.NewAPIError(res)
+		if res.Body != nil {
+			res.Body.Close()
+		}
 		return nil, apiError
 	}
 
 	return res, nil
 }
 
+// Prepares the body of a request to be sent again, which is impossible for bodies without GetBody.
+func (p *HTTPPort) rewind(req *http.Request) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true
+	}
+	if req.GetBody == nil {
+		return false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	req.Body = body
+	return true
+}
+
 func (p *HTTPPort) successfulRequest(res *http.Response) bool {
 	if res.StatusCode == 200 || res.StatusCode == 201 || res.StatusCode == 204 {
 		return true
 	}
 	return false
 }
+
+// Releases the context of a request once its response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
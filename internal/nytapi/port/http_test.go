@@ -2,11 +2,13 @@ package port_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -206,3 +208,156 @@ func Test_HTTPPort_HandlesFailureResponseWithInvalidJSONBody_WithError(t *testin
 	assert.NotNil(t, err)
 	assert.Implements(t, (*error)(nil), err)
 }
+
+func Test_HTTPPort_SetsUserAgent_WithValue(t *testing.T) {
+	var userAgent string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			userAgent = req.Header.Get("User-Agent")
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test.com",
+		UserAgent:  "gonyt-test/1.0",
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, "gonyt-test/1.0", userAgent)
+}
+
+func Test_HTTPPort_AppliesDefaultTimeoutWithoutDeadline_WithValue(t *testing.T) {
+	var deadline time.Time
+	var hasDeadline bool
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			deadline, hasDeadline = req.Context().Deadline()
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient:     &mockedHTTPClient,
+		BaseURL:        "https://test.com",
+		DefaultTimeout: time.Minute,
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.True(t, hasDeadline)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+}
+
+func Test_HTTPPort_KeepsDeadlineOfContext_WithValue(t *testing.T) {
+	var deadline time.Time
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			deadline, _ = req.Context().Deadline()
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient:     &mockedHTTPClient,
+		BaseURL:        "https://test.com",
+		DefaultTimeout: time.Second,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	expectedDeadline, _ := ctx.Deadline()
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil).WithContext(ctx)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, expectedDeadline, deadline)
+}
+
+func Test_HTTPPort_DefaultTimeoutLastsUntilBodyIsClosed_WithValue(t *testing.T) {
+	var reqCtx context.Context
+	body := ioutil.NopCloser(bytes.NewReader([]byte(`{}`)))
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			reqCtx = req.Context()
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient:     &mockedHTTPClient,
+		BaseURL:        "https://test.com",
+		DefaultTimeout: time.Minute,
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.Nil(t, reqCtx.Err())
+	res.Body.Close()
+	assert.Equal(t, context.Canceled, reqCtx.Err())
+}
+
+func Test_HTTPPort_CallsRequestHooksInOrder_WithValue(t *testing.T) {
+	calls := []string{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			calls = append(calls, req.Header.Get("X-Test"))
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test.com",
+		RequestHooks: []port.RequestHook{
+			func(req *http.Request) error {
+				calls = append(calls, "first")
+				req.Header.Set("X-Test", "hooked")
+				return nil
+			},
+			func(req *http.Request) error {
+				calls = append(calls, "second")
+				return nil
+			},
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, []string{"first", "second", "hooked"}, calls)
+}
+
+func Test_HTTPPort_RequestHookError_WithError(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test.com",
+		RequestHooks: []port.RequestHook{
+			func(req *http.Request) error {
+				return errors.New("test error")
+			},
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, res)
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, requests)
+}
@@ -0,0 +1,251 @@
+package port
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+)
+
+// DefaultKeyCooldown a key rests after exceeding its quota, unless the API asks for longer via Retry-After.
+const DefaultKeyCooldown = time.Minute
+
+// KeyPool of API keys used in turn, safe for concurrent use.
+//
+// Requests are sent with the current key until it exceeds its quota, either by the API answering with
+// TooManyRequestsError or by exhausting its daily budget. The key then cools down and the pool rotates to the
+// next healthy key. Each key is limited by its own RateLimiter, a StatePath of the RateLimit is suffixed with
+// the fingerprint of the key. If every key is cooling down, requests wait for the first key to recover
+// unless the RateLimit fails fast, keys having exhausted their daily budget are not waited for.
+// A single key never cools down on TooManyRequestsError, leaving the handling to the RetryPolicy.
+type KeyPool struct {
+	mutex    sync.Mutex
+	keys     []*poolKey
+	current  int
+	cooldown time.Duration
+	failFast bool
+}
+
+type poolKey struct {
+	key            string
+	fingerprint    string
+	limiter        *RateLimiter
+	requests       int
+	rateLimited    int
+	coolingUntil   time.Time
+	budgetExceeded bool
+}
+
+// KeyUsage reports the usage of a key of a KeyPool, identifying the key by its fingerprint.
+// DailyUsed and DailyBudget are only known if the pool is rate limited, they are shared with other processes
+// via the state file of the RateLimit. Requests, RateLimited and CoolingUntil only cover the pool itself.
+type KeyUsage struct {
+	Fingerprint  string    `json:"fingerprint"`
+	Requests     int       `json:"requests"`
+	RateLimited  int       `json:"rate_limited"`
+	DailyUsed    int       `json:"daily_used"`
+	DailyBudget  int       `json:"daily_budget"`
+	CoolingUntil time.Time `json:"cooling_until"`
+}
+
+// NewKeyPool provides a pool of the given keys, skipping empty and duplicate keys.
+// A cooldown of zero uses the DefaultKeyCooldown.
+func NewKeyPool(keys []string, limit RateLimit, cooldown time.Duration) *KeyPool {
+	if cooldown <= 0 {
+		cooldown = DefaultKeyCooldown
+	}
+	pool := &KeyPool{
+		cooldown: cooldown,
+		failFast: limit.FailFast,
+	}
+
+	seen := map[string]bool{}
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		fingerprint := KeyFingerprint(key)
+		keyLimit := limit
+		if keyLimit.StatePath != "" {
+			extension := filepath.Ext(keyLimit.StatePath)
+			keyLimit.StatePath = strings.TrimSuffix(keyLimit.StatePath, extension) + "-" + fingerprint + extension
+		}
+		pool.keys = append(pool.keys, &poolKey{
+			key:         key,
+			fingerprint: fingerprint,
+			limiter:     NewRateLimiter(keyLimit),
+		})
+	}
+	return pool
+}
+
+// KeyFingerprint identifies an API key without revealing it.
+func KeyFingerprint(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:4])
+}
+
+// Len returns the number of keys in the pool.
+func (p *KeyPool) Len() int {
+	return len(p.keys)
+}
+
+// Usage reports the usage of every key in the pool, in the order the keys were given.
+func (p *KeyPool) Usage() ([]KeyUsage, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	usage := []KeyUsage{}
+	for _, key := range p.keys {
+		dailyUsed, err := key.limiter.Used()
+		if err != nil {
+			return nil, err
+		}
+		usage = append(usage, KeyUsage{
+			Fingerprint:  key.fingerprint,
+			Requests:     key.requests,
+			RateLimited:  key.rateLimited,
+			DailyUsed:    dailyUsed,
+			DailyBudget:  key.limiter.limit.DailyBudget,
+			CoolingUntil: key.coolingUntil,
+		})
+	}
+	return usage, nil
+}
+
+// Sets the key to use on a request, waiting for a healthy key and its rate limit.
+func (p *KeyPool) authorize(req *http.Request) (*poolKey, error) {
+	for {
+		key, wait, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if wait > 0 {
+			if p.failFast {
+				return nil, RateLimitError{AllKeysCoolingDown: true, Wait: wait}
+			}
+			if !waitForRetry(req.Context(), wait) {
+				if err := req.Context().Err(); err != nil {
+					return nil, err
+				}
+				return nil, RateLimitError{AllKeysCoolingDown: true, Wait: wait}
+			}
+		}
+
+		err = key.limiter.Wait(req.Context())
+		var rateLimitError RateLimitError
+		if errors.As(err, &rateLimitError) && rateLimitError.DailyBudgetExhausted {
+			p.coolDown(key, rateLimitError.Wait, true)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		p.mutex.Lock()
+		key.requests++
+		p.mutex.Unlock()
+
+		query := req.URL.Query()
+		query.Set("api-key", key.key)
+		req.URL.RawQuery = query.Encode()
+		return key, nil
+	}
+}
+
+// Returns the current key if healthy, otherwise rotates to the next healthy key. If every key is cooling down,
+// the key recovering first is returned along with the time left.
+func (p *KeyPool) next() (*poolKey, time.Duration, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := time.Now()
+	var first *poolKey
+	firstIndex := 0
+	for i := 0; i < len(p.keys); i++ {
+		index := (p.current + i) % len(p.keys)
+		key := p.keys[index]
+		if !now.Before(key.coolingUntil) {
+			key.budgetExceeded = false
+			p.current = index
+			return key, 0, nil
+		}
+		if key.budgetExceeded {
+			continue
+		}
+		if first == nil || key.coolingUntil.Before(first.coolingUntil) {
+			first = key
+			firstIndex = index
+		}
+	}
+
+	if first == nil {
+		return nil, 0, RateLimitError{DailyBudgetExhausted: true, AllKeysCoolingDown: len(p.keys) > 1, Wait: p.earliestRecovery(now)}
+	}
+	p.current = firstIndex
+	return first, first.coolingUntil.Sub(now), nil
+}
+
+func (p *KeyPool) earliestRecovery(now time.Time) time.Duration {
+	var earliest time.Duration
+	for i, key := range p.keys {
+		if wait := key.coolingUntil.Sub(now); i == 0 || wait < earliest {
+			earliest = wait
+		}
+	}
+	return earliest
+}
+
+// Takes note of the outcome of a request sent with the key. Returns true if the key exceeded its quota
+// and the pool rotated to another key which is healthy, so that the request can be sent again right away.
+func (p *KeyPool) report(key *poolKey, err error) bool {
+	var apiError apierror.APIError
+	if !errors.As(err, &apiError) || apiError.Type != apierror.TooManyRequestsError {
+		return false
+	}
+
+	if len(p.keys) < 2 {
+		p.mutex.Lock()
+		key.rateLimited++
+		p.mutex.Unlock()
+		return false
+	}
+
+	cooldown := p.cooldown
+	if apiError.RetryAfter > cooldown {
+		cooldown = apiError.RetryAfter
+	}
+	p.coolDown(key, cooldown, false)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	key.rateLimited++
+	now := time.Now()
+	for _, other := range p.keys {
+		if !now.Before(other.coolingUntil) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *KeyPool) coolDown(key *poolKey, cooldown time.Duration, budgetExceeded bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	key.coolingUntil = time.Now().Add(cooldown)
+	key.budgetExceeded = budgetExceeded
+	if len(p.keys) > 0 && p.keys[p.current] == key {
+		p.current = (p.current + 1) % len(p.keys)
+	}
+}
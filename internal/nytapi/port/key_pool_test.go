@@ -0,0 +1,302 @@
+package port_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// Answers requests of the given keys with 429, any other key succeeds. Records the keys used.
+func newMockedQuotaHTTPClient(exhaustedKeys map[string]bool, usedKeys *[]string) *port.MockedHTTPClient {
+	return &port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			key := req.URL.Query().Get("api-key")
+			*usedKeys = append(*usedKeys, key)
+			if exhaustedKeys[key] {
+				return &http.Response{StatusCode: 429}, nil
+			}
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+}
+
+func Test_KeyPool_SkipsEmptyAndDuplicateKeys_WithValue(t *testing.T) {
+	sut := port.NewKeyPool([]string{"first", "", " ", "second", "first"}, port.RateLimit{}, 0)
+
+	usage, err := sut.Usage()
+
+	require.Nil(t, err)
+	assert.Equal(t, 2, sut.Len())
+	if assert.Len(t, usage, 2) {
+		assert.Equal(t, port.KeyFingerprint("first"), usage[0].Fingerprint)
+		assert.Equal(t, port.KeyFingerprint("second"), usage[1].Fingerprint)
+	}
+}
+
+func Test_KeyPool_KeyFingerprintDoesNotRevealKey_WithValue(t *testing.T) {
+	fingerprint := port.KeyFingerprint("mockedApiKey")
+
+	assert.Len(t, fingerprint, 8)
+	assert.NotContains(t, fingerprint, "mockedApiKey")
+	assert.Equal(t, fingerprint, port.KeyFingerprint("mockedApiKey"))
+	assert.NotEqual(t, fingerprint, port.KeyFingerprint("otherApiKey"))
+}
+
+func Test_KeyPool_MarshalsUsageInSnakeCase_WithValue(t *testing.T) {
+	sut := port.NewKeyPool([]string{"first"}, port.RateLimit{DailyBudget: 500}, 0)
+
+	usage, err := sut.Usage()
+	require.Nil(t, err)
+	data, err := json.Marshal(usage)
+
+	require.Nil(t, err)
+	assert.JSONEq(t, `[{
+		"fingerprint": "`+port.KeyFingerprint("first")+`",
+		"requests": 0,
+		"rate_limited": 0,
+		"daily_used": 0,
+		"daily_budget": 500,
+		"cooling_until": "0001-01-01T00:00:00Z"
+	}]`, string(data))
+}
+
+func Test_HTTPPort_AddsAPIKeyToQuery_WithValue(t *testing.T) {
+	usedKeys := []string{}
+	var rawQuery string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			usedKeys = append(usedKeys, req.URL.Query().Get("api-key"))
+			rawQuery = req.URL.RawQuery
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test.com",
+		APIKeys:    port.NewKeyPool([]string{"first"}, port.RateLimit{}, 0),
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com/names.json?offset=20&api-key=injected", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, []string{"first"}, usedKeys)
+	assert.Equal(t, "api-key=first&offset=20", rawQuery)
+}
+
+func Test_HTTPPort_RotatesKeyOnTooManyRequests_WithValue(t *testing.T) {
+	usedKeys := []string{}
+	pool := port.NewKeyPool([]string{"first", "second", "third"}, port.RateLimit{}, time.Hour)
+	sut := port.HTTPPort{
+		HTTPClient: newMockedQuotaHTTPClient(map[string]bool{"first": true, "second": true}, &usedKeys),
+		BaseURL:    "https://test.com",
+		APIKeys:    pool,
+	}
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+		res, err := sut.Do(req)
+
+		require.Nil(t, err)
+		assert.NotNil(t, res)
+	}
+
+	assert.Equal(t, []string{"first", "second", "third", "third"}, usedKeys)
+	usage, err := pool.Usage()
+	require.Nil(t, err)
+	if assert.Len(t, usage, 3) {
+		assert.Equal(t, 1, usage[0].Requests)
+		assert.Equal(t, 1, usage[0].RateLimited)
+		assert.True(t, usage[0].CoolingUntil.After(time.Now()))
+		assert.Equal(t, 1, usage[1].RateLimited)
+		assert.Equal(t, 2, usage[2].Requests)
+		assert.Equal(t, 0, usage[2].RateLimited)
+		assert.True(t, usage[2].CoolingUntil.IsZero())
+	}
+}
+
+func Test_HTTPPort_FailsOnTooManyRequestsOfAllKeys_WithError(t *testing.T) {
+	usedKeys := []string{}
+	sut := port.HTTPPort{
+		HTTPClient: newMockedQuotaHTTPClient(map[string]bool{"first": true, "second": true}, &usedKeys),
+		BaseURL:    "https://test.com",
+		APIKeys:    port.NewKeyPool([]string{"first", "second"}, port.RateLimit{FailFast: true}, time.Hour),
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, res)
+	assert.IsType(t, apierror.APIError{}, err)
+	assert.Equal(t, []string{"first", "second"}, usedKeys)
+
+	req = httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err = sut.Do(req)
+
+	require.Nil(t, res)
+	if assert.IsType(t, port.RateLimitError{}, err) {
+		assert.True(t, err.(port.RateLimitError).AllKeysCoolingDown)
+		assert.False(t, err.(port.RateLimitError).DailyBudgetExhausted)
+	}
+	assert.Len(t, usedKeys, 2)
+}
+
+func Test_HTTPPort_WaitsForCoolingKey_WithValue(t *testing.T) {
+	usedKeys := []string{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			usedKeys = append(usedKeys, req.URL.Query().Get("api-key"))
+			if len(usedKeys) <= 2 {
+				return &http.Response{StatusCode: 429}, nil
+			}
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient:  &mockedHTTPClient,
+		BaseURL:     "https://test.com",
+		APIKeys:     port.NewKeyPool([]string{"first", "second"}, port.RateLimit{}, 100*time.Millisecond),
+		RetryPolicy: port.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	}
+
+	start := time.Now()
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, []string{"first", "second", "first"}, usedKeys)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(100*time.Millisecond))
+}
+
+func Test_HTTPPort_DoesNotCoolDownSingleKey_WithValue(t *testing.T) {
+	usedKeys := []string{}
+	pool := port.NewKeyPool([]string{"first"}, port.RateLimit{FailFast: true}, time.Hour)
+	sut := port.HTTPPort{
+		HTTPClient: newMockedQuotaHTTPClient(map[string]bool{"first": true}, &usedKeys),
+		BaseURL:    "https://test.com",
+		APIKeys:    pool,
+	}
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+		res, err := sut.Do(req)
+
+		require.Nil(t, res)
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+
+	assert.Equal(t, []string{"first", "first"}, usedKeys)
+	usage, err := pool.Usage()
+	require.Nil(t, err)
+	if assert.Len(t, usage, 1) {
+		assert.Equal(t, 2, usage[0].RateLimited)
+		assert.True(t, usage[0].CoolingUntil.IsZero())
+	}
+}
+
+func Test_HTTPPort_DoesNotWaitForCoolingKeyBeyondDeadline_WithError(t *testing.T) {
+	usedKeys := []string{}
+	sut := port.HTTPPort{
+		HTTPClient: newMockedQuotaHTTPClient(map[string]bool{"first": true, "second": true}, &usedKeys),
+		BaseURL:    "https://test.com",
+		APIKeys:    port.NewKeyPool([]string{"first", "second"}, port.RateLimit{}, time.Hour),
+	}
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	_, err := sut.Do(req)
+	require.IsType(t, apierror.APIError{}, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req = httptest.NewRequest(http.MethodGet, "https://test.com", nil).WithContext(ctx)
+	res, err := sut.Do(req)
+
+	require.Nil(t, res)
+	assert.IsType(t, port.RateLimitError{}, err)
+	assert.Len(t, usedKeys, 2)
+}
+
+func Test_HTTPPort_RotatesKeyOnExhaustedDailyBudget_WithValue(t *testing.T) {
+	usedKeys := []string{}
+	pool := port.NewKeyPool([]string{"first", "second"}, port.RateLimit{DailyBudget: 2}, 0)
+	sut := port.HTTPPort{
+		HTTPClient: newMockedQuotaHTTPClient(nil, &usedKeys),
+		BaseURL:    "https://test.com",
+		APIKeys:    pool,
+	}
+
+	for i := 0; i < 4; i++ {
+		req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+		_, err := sut.Do(req)
+		require.Nil(t, err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, res)
+	if assert.IsType(t, port.RateLimitError{}, err) {
+		assert.True(t, err.(port.RateLimitError).DailyBudgetExhausted)
+		assert.True(t, err.(port.RateLimitError).AllKeysCoolingDown)
+	}
+	assert.Equal(t, []string{"first", "first", "second", "second"}, usedKeys)
+	usage, err := pool.Usage()
+	require.Nil(t, err)
+	if assert.Len(t, usage, 2) {
+		assert.Equal(t, 2, usage[0].DailyUsed)
+		assert.Equal(t, 2, usage[0].DailyBudget)
+		assert.Equal(t, 2, usage[1].DailyUsed)
+	}
+}
+
+func Test_HTTPPort_SharesPersistedDailyBudgetPerKey_WithValue(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "usage.json")
+	limit := port.RateLimit{DailyBudget: 1, StatePath: statePath}
+	usedKeys := []string{}
+
+	for i := 0; i < 2; i++ {
+		sut := port.HTTPPort{
+			HTTPClient: newMockedQuotaHTTPClient(nil, &usedKeys),
+			BaseURL:    "https://test.com",
+			APIKeys:    port.NewKeyPool([]string{"first", "second"}, limit, 0),
+		}
+		req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+		_, err := sut.Do(req)
+		require.Nil(t, err)
+	}
+
+	assert.Equal(t, []string{"first", "second"}, usedKeys)
+	assert.FileExists(t, filepath.Join(filepath.Dir(statePath), "usage-"+port.KeyFingerprint("first")+".json"))
+	assert.FileExists(t, filepath.Join(filepath.Dir(statePath), "usage-"+port.KeyFingerprint("second")+".json"))
+}
+
+func Test_HTTPPort_SendsRequestWithoutKeys_WithValue(t *testing.T) {
+	var rawQuery string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			rawQuery = req.URL.RawQuery
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test.com",
+		APIKeys:    port.NewKeyPool([]string{""}, port.RateLimit{}, 0),
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com/feed.xml", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, "", rawQuery)
+}
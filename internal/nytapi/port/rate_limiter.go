@@ -0,0 +1,301 @@
+package port
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Quotas of an API key of the New York Times API as per their FAQ.
+const (
+	DefaultRequestsPerMinute = 5
+	DefaultDailyBudget       = 500
+)
+
+// RateLimit configures a RateLimiter.
+//
+// Requests are admitted at RequestsPerMinute, allowing up to Burst requests at once, which defaults to a single one.
+// At most DailyBudget requests are admitted per day in UTC. Requests exceeding the rate are blocked until they
+// fit in, unless FailFast is set, requests exceeding the daily budget always fail. If a StatePath is given
+// the daily counter is persisted to that file, so that concurrent and subsequent processes share one budget.
+// The file is guarded by a lock file next to it while being updated.
+// Zero values disable the respective limit.
+type RateLimit struct {
+	RequestsPerMinute int
+	Burst             int
+	DailyBudget       int
+	FailFast          bool
+	StatePath         string
+}
+
+// RateLimitError is returned in place of executing a request which exceeds the rate limit or the daily budget,
+// or in case every key of a KeyPool is cooling down.
+type RateLimitError struct {
+	DailyBudgetExhausted bool
+	AllKeysCoolingDown   bool
+	Wait                 time.Duration
+}
+
+func (err RateLimitError) Error() string {
+	switch {
+	case err.DailyBudgetExhausted && err.AllKeysCoolingDown:
+		return fmt.Sprintf("Daily budget of all API keys exhausted. No further requests are sent today, the first budget resets in %v.", err.Wait.Round(time.Minute))
+	case err.DailyBudgetExhausted:
+		return fmt.Sprintf("Daily budget exhausted. No further requests are sent today, the budget resets in %v.", err.Wait.Round(time.Minute))
+	case err.AllKeysCoolingDown:
+		return fmt.Sprintf("All API keys exceeded their quota. The first key can be used again in %v.", err.Wait.Round(time.Second))
+	}
+	return fmt.Sprintf("Rate limit exceeded. The next request can be sent in %v.", err.Wait.Round(time.Millisecond))
+}
+
+// Polling interval while waiting for the lock on a state file, and the age after which a lock is considered stale.
+const (
+	stateLockRetryDelay = 5 * time.Millisecond
+	stateLockStaleAfter = 10 * time.Second
+)
+
+// RateLimiter is a token bucket admitting requests according to a RateLimit, safe for concurrent use.
+type RateLimiter struct {
+	mutex  sync.Mutex
+	limit  RateLimit
+	tokens float64
+	filled time.Time
+	day    string
+	used   int
+}
+
+// State of the daily budget as persisted to the state file.
+type rateLimiterState struct {
+	Day  string `json:"day"`
+	Used int    `json:"used"`
+}
+
+// NewRateLimiter provides a rate limiter starting with a full bucket.
+func NewRateLimiter(limit RateLimit) *RateLimiter {
+	if limit.Burst <= 0 {
+		limit.Burst = 1
+	}
+	return &RateLimiter{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		filled: time.Now(),
+	}
+}
+
+// Wait admits a request, blocking until the rate limit allows for it unless the limiter fails fast.
+// It fails with a RateLimitError if the request cannot be admitted, or with the error of the context
+// if the context ends first.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	wait, err := l.admit(ctx)
+	if err != nil || wait <= 0 {
+		return err
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.release()
+		return ctx.Err()
+	}
+}
+
+// Counts a request against the daily budget and takes a token, returning how long to wait for the token.
+// The state file stays locked throughout, so that processes sharing it neither exceed nor under-count the budget.
+func (l *RateLimiter) admit(ctx context.Context) (time.Duration, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	unlock, err := l.lockState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	now := time.Now()
+	if err := l.loadDailyBudget(now); err != nil {
+		return 0, err
+	}
+	if l.limit.DailyBudget > 0 && l.used >= l.limit.DailyBudget {
+		return 0, RateLimitError{DailyBudgetExhausted: true, Wait: untilNextDay(now)}
+	}
+
+	wait := l.reserve(now)
+	if wait > 0 && l.limit.FailFast {
+		l.tokens++
+		return 0, RateLimitError{Wait: wait}
+	}
+	if deadline, hasDeadline := ctx.Deadline(); hasDeadline && time.Until(deadline) < wait {
+		l.tokens++
+		return 0, RateLimitError{Wait: wait}
+	}
+
+	l.used++
+	if err := l.storeDailyBudget(); err != nil {
+		l.used--
+		l.tokens++
+		return 0, err
+	}
+	return wait, nil
+}
+
+// Used returns the number of requests admitted today, including those of other processes sharing the state file.
+func (l *RateLimiter) Used() (int, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if err := l.loadDailyBudget(time.Now()); err != nil {
+		return 0, err
+	}
+	return l.used, nil
+}
+
+// Takes a token out of the bucket, returning how long to wait until the token is actually available.
+// Taking tokens in advance queues concurrent requests in the order they arrived.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	if l.limit.RequestsPerMinute <= 0 {
+		return 0
+	}
+
+	perToken := time.Minute / time.Duration(l.limit.RequestsPerMinute)
+	l.tokens += float64(now.Sub(l.filled)) / float64(perToken)
+	if l.tokens > float64(l.limit.Burst) {
+		l.tokens = float64(l.limit.Burst)
+	}
+	l.filled = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(perToken))
+}
+
+// Hands back a reserved token and its share of the daily budget once a request is abandoned.
+func (l *RateLimiter) release() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.tokens++
+	// The context of the abandoned request has ended already, the budget is handed back regardless.
+	unlock, err := l.lockState(context.Background())
+	if err != nil {
+		return
+	}
+	defer unlock()
+
+	if err := l.loadDailyBudget(time.Now()); err == nil && l.used > 0 {
+		l.used--
+		l.storeDailyBudget()
+	}
+}
+
+func (l *RateLimiter) persisted() bool {
+	return l.limit.DailyBudget > 0 && l.limit.StatePath != ""
+}
+
+// Locks the state file against other processes by creating a lock file next to it, returning the function
+// to unlock it again. A lock file older than stateLockStaleAfter was left behind by a crashed process and is broken.
+// Waiting for the lock ends with the error of the context if the context ends first.
+func (l *RateLimiter) lockState(ctx context.Context) (func(), error) {
+	if !l.persisted() {
+		return func() {}, nil
+	}
+
+	lockPath := l.limit.StatePath + ".lock"
+	for {
+		lock, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			lock.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("locking the rate limit state failed with error: %v", err)
+		}
+
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > stateLockStaleAfter {
+			os.Remove(lockPath)
+			continue
+		}
+		timer := time.NewTimer(stateLockRetryDelay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Starts the budget afresh on a new day, picking up the counter of other processes from the state file.
+// The counter kept in memory is never lowered by the state file, a state file which cannot be unmarshaled
+// is treated like a missing one and replaced on the next write.
+func (l *RateLimiter) loadDailyBudget(now time.Time) error {
+	today := now.UTC().Format("2006-01-02")
+	if l.day != today {
+		l.day = today
+		l.used = 0
+	}
+	if !l.persisted() {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(l.limit.StatePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading the rate limit state failed with error: %v", err)
+	}
+
+	var state rateLimiterState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil
+	}
+	if state.Day == today && state.Used > l.used {
+		l.used = state.Used
+	}
+	return nil
+}
+
+// Replaces the state file atomically by renaming a temporary file, so that readers never see a partial write.
+func (l *RateLimiter) storeDailyBudget() error {
+	if !l.persisted() {
+		return nil
+	}
+
+	data, err := json.Marshal(rateLimiterState{Day: l.day, Used: l.used})
+	if err != nil {
+		return fmt.Errorf("marshaling the rate limit state failed with error: %v", err)
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(l.limit.StatePath), filepath.Base(l.limit.StatePath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("writing the rate limit state failed with error: %v", err)
+	}
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), l.limit.StatePath)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return fmt.Errorf("writing the rate limit state failed with error: %v", err)
+	}
+	return nil
+}
+
+func untilNextDay(now time.Time) time.Duration {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC).Sub(now)
+}
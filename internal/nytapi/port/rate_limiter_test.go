@@ -0,0 +1,191 @@
+package port_test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+func Test_RateLimiter_AdmitsBurstImmediately_WithValue(t *testing.T) {
+	sut := port.NewRateLimiter(port.RateLimit{RequestsPerMinute: 1, Burst: 3, FailFast: true})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		require.Nil(t, sut.Wait(ctx))
+	}
+	err := sut.Wait(ctx)
+
+	if assert.IsType(t, port.RateLimitError{}, err) {
+		assert.False(t, err.(port.RateLimitError).DailyBudgetExhausted)
+		assert.InDelta(t, float64(time.Minute), float64(err.(port.RateLimitError).Wait), float64(time.Second))
+	}
+}
+
+func Test_RateLimiter_BlocksUntilTokenIsAvailable_WithValue(t *testing.T) {
+	sut := port.NewRateLimiter(port.RateLimit{RequestsPerMinute: 600})
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.Nil(t, sut.Wait(ctx))
+	}
+
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(190*time.Millisecond))
+}
+
+func Test_RateLimiter_QueuesConcurrentRequests_WithValue(t *testing.T) {
+	sut := port.NewRateLimiter(port.RateLimit{RequestsPerMinute: 1200})
+	ctx := context.Background()
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, sut.Wait(ctx))
+		}()
+	}
+	wg.Wait()
+
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(190*time.Millisecond))
+}
+
+func Test_RateLimiter_FailsOnDeadlineBeforeToken_WithError(t *testing.T) {
+	sut := port.NewRateLimiter(port.RateLimit{RequestsPerMinute: 1})
+	require.Nil(t, sut.Wait(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := sut.Wait(ctx)
+
+	assert.IsType(t, port.RateLimitError{}, err)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+}
+
+func Test_RateLimiter_ReleasesTokenOnCancel_WithValue(t *testing.T) {
+	sut := port.NewRateLimiter(port.RateLimit{RequestsPerMinute: 600})
+	require.Nil(t, sut.Wait(context.Background()))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := sut.Wait(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	start := time.Now()
+	require.Nil(t, sut.Wait(context.Background()))
+	assert.Less(t, int64(time.Since(start)), int64(150*time.Millisecond))
+}
+
+func Test_RateLimiter_ExhaustsDailyBudget_WithError(t *testing.T) {
+	sut := port.NewRateLimiter(port.RateLimit{DailyBudget: 2})
+	ctx := context.Background()
+
+	require.Nil(t, sut.Wait(ctx))
+	require.Nil(t, sut.Wait(ctx))
+	err := sut.Wait(ctx)
+
+	if assert.IsType(t, port.RateLimitError{}, err) {
+		assert.True(t, err.(port.RateLimitError).DailyBudgetExhausted)
+		assert.LessOrEqual(t, int64(err.(port.RateLimitError).Wait), int64(24*time.Hour))
+	}
+}
+
+func Test_RateLimiter_SharesPersistedDailyBudget_WithValue(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "usage.json")
+	limit := port.RateLimit{DailyBudget: 3, StatePath: statePath}
+	ctx := context.Background()
+
+	first := port.NewRateLimiter(limit)
+	require.Nil(t, first.Wait(ctx))
+	require.Nil(t, first.Wait(ctx))
+
+	second := port.NewRateLimiter(limit)
+	require.Nil(t, second.Wait(ctx))
+	err := second.Wait(ctx)
+	assert.IsType(t, port.RateLimitError{}, err)
+
+	err = first.Wait(ctx)
+	assert.IsType(t, port.RateLimitError{}, err)
+}
+
+func Test_RateLimiter_ResetsPersistedDailyBudgetOnNewDay_WithValue(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "usage.json")
+	require.Nil(t, ioutil.WriteFile(statePath, []byte(`{"day":"2021-06-27","used":500}`), 0600))
+	sut := port.NewRateLimiter(port.RateLimit{DailyBudget: 500, StatePath: statePath})
+
+	err := sut.Wait(context.Background())
+
+	require.Nil(t, err)
+	data, err := ioutil.ReadFile(statePath)
+	require.Nil(t, err)
+	assert.Contains(t, string(data), `"used":1`)
+	assert.Contains(t, string(data), time.Now().UTC().Format("2006-01-02"))
+}
+
+func Test_RateLimiter_ResetsInvalidPersistedState_WithValue(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "usage.json")
+	require.Nil(t, ioutil.WriteFile(statePath, []byte(`{"day":"20`), 0600))
+	sut := port.NewRateLimiter(port.RateLimit{DailyBudget: 500, StatePath: statePath})
+
+	err := sut.Wait(context.Background())
+
+	require.Nil(t, err)
+	used, err := sut.Used()
+	require.Nil(t, err)
+	assert.Equal(t, 1, used)
+	data, err := ioutil.ReadFile(statePath)
+	require.Nil(t, err)
+	assert.Contains(t, string(data), `"used":1`)
+}
+
+func Test_RateLimiter_CountsConcurrentLimitersSharingState_WithValue(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "usage.json")
+	limit := port.RateLimit{DailyBudget: 500, StatePath: statePath}
+	limiters := []*port.RateLimiter{port.NewRateLimiter(limit), port.NewRateLimiter(limit), port.NewRateLimiter(limit)}
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for _, limiter := range limiters {
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(limiter *port.RateLimiter) {
+				defer wg.Done()
+				assert.Nil(t, limiter.Wait(ctx))
+			}(limiter)
+		}
+	}
+	wg.Wait()
+
+	used, err := port.NewRateLimiter(limit).Used()
+	require.Nil(t, err)
+	assert.Equal(t, 60, used)
+	files, err := ioutil.ReadDir(filepath.Dir(statePath))
+	require.Nil(t, err)
+	assert.Len(t, files, 1)
+}
+
+func Test_RateLimiter_StopsWaitingForLockedStateOnCancel_WithError(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "usage.json")
+	require.Nil(t, ioutil.WriteFile(statePath+".lock", nil, 0600))
+	sut := port.NewRateLimiter(port.RateLimit{DailyBudget: 500, StatePath: statePath})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := sut.Wait(ctx)
+
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	used, err := sut.Used()
+	require.Nil(t, err)
+	assert.Equal(t, 0, used)
+}
@@ -0,0 +1,42 @@
+package port
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted takes the place of API keys scrubbed from errors and URLs.
+const Redacted = "REDACTED"
+
+// Value of an api-key query parameter, regardless of the key it holds.
+var apiKeyParameter = regexp.MustCompile(`(?i)(api-key=)[^&#\s"']+`)
+
+// RedactAPIKeys scrubs the given keys, plain as well as query escaped, and the value of any api-key query parameter
+// from a text such as an error message, URL or debug dump, so that it can be shared safely.
+func RedactAPIKeys(text string, keys ...string) string {
+	text = apiKeyParameter.ReplaceAllString(text, "${1}"+Redacted)
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		text = strings.ReplaceAll(text, key, Redacted)
+		if escaped := url.QueryEscape(key); escaped != key {
+			text = strings.ReplaceAll(text, escaped, Redacted)
+		}
+	}
+	return text
+}
+
+// Redact scrubs the keys of the pool from a text, see RedactAPIKeys.
+func (p *KeyPool) Redact(text string) string {
+	if p == nil {
+		return RedactAPIKeys(text)
+	}
+
+	keys := []string{}
+	for _, key := range p.keys {
+		keys = append(keys, key.key)
+	}
+	return RedactAPIKeys(text, keys...)
+}
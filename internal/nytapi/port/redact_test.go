@@ -0,0 +1,143 @@
+package port_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+func Test_RedactAPIKeys_WithValue(t *testing.T) {
+	var cases = []struct {
+		text     string
+		keys     []string
+		expected string
+	}{
+		{"no key in here", []string{"secret"}, "no key in here"},
+		{"https://test.com/names.json?api-key=secret&offset=20", nil, "https://test.com/names.json?api-key=REDACTED&offset=20"},
+		{"https://test.com/names.json?offset=20&API-KEY=secret", nil, "https://test.com/names.json?offset=20&API-KEY=REDACTED"},
+		{`Get "https://test.com/names.json?api-key=secret": dial tcp`, nil, `Get "https://test.com/names.json?api-key=REDACTED": dial tcp`},
+		{"using key secret for the request", []string{"secret"}, "using key REDACTED for the request"},
+		{"escaped key a%2Bb%2Fc", []string{"a+b/c"}, "escaped key REDACTED"},
+		{"first and second", []string{"first", "", "second"}, "REDACTED and REDACTED"},
+	}
+
+	for _, tt := range cases {
+		assert.Equal(t, tt.expected, port.RedactAPIKeys(tt.text, tt.keys...), tt.text)
+	}
+}
+
+func Test_KeyPool_Redact_WithValue(t *testing.T) {
+	sut := port.NewKeyPool([]string{"first", "second"}, port.RateLimit{}, 0)
+
+	assert.Equal(t, "REDACTED, REDACTED", sut.Redact("first, second"))
+	assert.Equal(t, "?api-key=REDACTED", (*port.KeyPool)(nil).Redact("?api-key=third"))
+}
+
+func Test_HTTPPort_RedactsAPIKeyFromExecutionError_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return nil, &url.Error{Op: "Get", URL: req.URL.String(), Err: errors.New("connection refused by secretApiKey")}
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test.com",
+		APIKeys:    port.NewKeyPool([]string{"secretApiKey"}, port.RateLimit{}, 0),
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com/names.json", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, res)
+	if assert.NotNil(t, err) {
+		assert.NotContains(t, err.Error(), "secretApiKey")
+		assert.Contains(t, err.Error(), "https://test.com/names.json?api-key=REDACTED")
+	}
+}
+
+func Test_HTTPPort_RedactsAPIKeyFromHookError_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test.com",
+		APIKeys:    port.NewKeyPool([]string{"secretApiKey"}, port.RateLimit{}, 0),
+		RequestHooks: []port.RequestHook{
+			func(*http.Request) error {
+				return errors.New("refusing secretApiKey")
+			},
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com/names.json", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, res)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "The request hook failed with error: refusing REDACTED", err.Error())
+	}
+}
+
+func Test_HTTPPort_KeepsTypedErrors_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 401}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test.com",
+		APIKeys:    port.NewKeyPool([]string{"secretApiKey"}, port.RateLimit{}, 0),
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com/names.json", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, res)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_HTTPPort_HooksDoNotSeeAPIKey_WithValue(t *testing.T) {
+	hookedURLs := []string{}
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			assert.Equal(t, "secretApiKey", req.URL.Query().Get("api-key"))
+			if requests == 1 {
+				return &http.Response{StatusCode: 503}, nil
+			}
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient:  &mockedHTTPClient,
+		BaseURL:     "https://test.com",
+		APIKeys:     port.NewKeyPool([]string{"secretApiKey"}, port.RateLimit{}, 0),
+		RetryPolicy: port.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+		RequestHooks: []port.RequestHook{
+			func(req *http.Request) error {
+				hookedURLs = append(hookedURLs, req.URL.String())
+				return nil
+			},
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com/names.json?offset=20", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, []string{"https://test.com/names.json?offset=20", "https://test.com/names.json?offset=20"}, hookedURLs)
+}
@@ -0,0 +1,98 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+)
+
+// Default delays of a retry policy created via NewRetryPolicy.
+const (
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+	DefaultRetryMaxDelay  = 30 * time.Second
+)
+
+// RetryPolicy decides whether and when a failed request is executed again.
+//
+// API errors which are safe to retry, see apierror.APIError.Retryable(), as well as failed executions are retried
+// until MaxAttempts requests have been made. Between attempts the policy waits for the delay requested by the server
+// via Retry-After or otherwise for an exponential backoff starting at BaseDelay, jittered and capped at MaxDelay.
+// A Retry-After exceeding MaxDelay ends the retries, as does a delay which would outlast the deadline of the request.
+// The zero value never retries.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// NewRetryPolicy provides a retry policy retrying a failed request the given number of times using the default delays.
+func NewRetryPolicy(retries int) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: retries + 1,
+		BaseDelay:   DefaultRetryBaseDelay,
+		MaxDelay:    DefaultRetryMaxDelay,
+	}
+}
+
+// Returns the delay to wait for after the given attempt failed with the error, or false if it must not be retried.
+// Attempts are counted starting at 1.
+func (p RetryPolicy) delay(attempt int, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	var apiError apierror.APIError
+	if errors.As(err, &apiError) {
+		if !apiError.Retryable() {
+			return 0, false
+		}
+		if apiError.RetryAfter > 0 {
+			if p.MaxDelay > 0 && apiError.RetryAfter > p.MaxDelay {
+				return 0, false
+			}
+			return apiError.RetryAfter, true
+		}
+	}
+
+	return p.backoff(attempt), true
+}
+
+// Doubles the base delay with every attempt. Only the upper half of the delay is fixed, the lower half is
+// jittered, so that clients failing at the same time do not retry at the same time as well.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// Waits for the delay to pass, returning false without waiting if the context would end before.
+func waitForRetry(ctx context.Context, delay time.Duration) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if deadline, hasDeadline := ctx.Deadline(); hasDeadline && time.Until(deadline) < delay {
+		return false
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
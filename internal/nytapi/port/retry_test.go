@@ -0,0 +1,237 @@
+package port_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+func Test_RetryPolicy_NewRetryPolicy_WithValue(t *testing.T) {
+	sut := port.NewRetryPolicy(2)
+
+	assert.Equal(t, 3, sut.MaxAttempts)
+	assert.Equal(t, port.DefaultRetryBaseDelay, sut.BaseDelay)
+	assert.Equal(t, port.DefaultRetryMaxDelay, sut.MaxDelay)
+}
+
+func Test_HTTPPort_RetriesRetryableErrorsUntilSuccess_WithValue(t *testing.T) {
+	statusCodes := []int{503, 429, 200}
+	requests := 0
+	hookCalls := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: statusCodes[requests-1]}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test.com",
+		RequestHooks: []port.RequestHook{
+			func(*http.Request) error {
+				hookCalls++
+				return nil
+			},
+		},
+		RetryPolicy: port.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, 3, hookCalls)
+}
+
+func Test_HTTPPort_RetriesUntilMaxAttempts_WithError(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 500}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient:  &mockedHTTPClient,
+		BaseURL:     "https://test.com",
+		RetryPolicy: port.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, res)
+	assert.IsType(t, apierror.APIError{}, err)
+	assert.Equal(t, 3, requests)
+}
+
+func Test_HTTPPort_RetriesFailedExecution_WithValue(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			if requests == 1 {
+				return nil, errors.New("connection reset")
+			}
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient:  &mockedHTTPClient,
+		BaseURL:     "https://test.com",
+		RetryPolicy: port.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, 2, requests)
+}
+
+func Test_HTTPPort_DoesNotRetry_WithError(t *testing.T) {
+	var cases = []struct {
+		name        string
+		statusCode  int
+		retryPolicy port.RetryPolicy
+	}{
+		{"not retryable", 404, port.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}},
+		{"unauthorized", 401, port.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}},
+		{"zero policy", 503, port.RetryPolicy{}},
+		{"single attempt", 503, port.RetryPolicy{MaxAttempts: 1, BaseDelay: time.Millisecond}},
+	}
+
+	for _, tt := range cases {
+		requests := 0
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(*http.Request) (*http.Response, error) {
+				requests++
+				return &http.Response{StatusCode: tt.statusCode}, nil
+			},
+		}
+		sut := port.HTTPPort{
+			HTTPClient:  &mockedHTTPClient,
+			BaseURL:     "https://test.com",
+			RetryPolicy: tt.retryPolicy,
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+		res, err := sut.Do(req)
+
+		require.Nil(t, res, tt.name)
+		assert.IsType(t, apierror.APIError{}, err, tt.name)
+		assert.Equal(t, 1, requests, tt.name)
+	}
+}
+
+func Test_HTTPPort_HonoursRetryAfter_WithValue(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			if requests == 1 {
+				return &http.Response{StatusCode: 429, Header: http.Header{"Retry-After": []string{"1"}}}, nil
+			}
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient:  &mockedHTTPClient,
+		BaseURL:     "https://test.com",
+		RetryPolicy: port.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Second},
+	}
+
+	start := time.Now()
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, 2, requests)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(time.Second))
+}
+
+func Test_HTTPPort_GivesUpOnRetryAfterExceedingMaxDelay_WithError(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 503, Header: http.Header{"Retry-After": []string{"3600"}}}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient:  &mockedHTTPClient,
+		BaseURL:     "https://test.com",
+		RetryPolicy: port.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil)
+	res, err := sut.Do(req)
+
+	require.Nil(t, res)
+	if assert.IsType(t, apierror.APIError{}, err) {
+		assert.Equal(t, time.Hour, err.(apierror.APIError).RetryAfter)
+	}
+	assert.Equal(t, 1, requests)
+}
+
+func Test_HTTPPort_DoesNotRetryBeyondDeadline_WithError(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 502}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient:  &mockedHTTPClient,
+		BaseURL:     "https://test.com",
+		RetryPolicy: port.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil).WithContext(ctx)
+	res, err := sut.Do(req)
+
+	require.Nil(t, res)
+	assert.IsType(t, apierror.APIError{}, err)
+	assert.Equal(t, 1, requests)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+}
+
+func Test_HTTPPort_StopsWaitingOnCancel_WithError(t *testing.T) {
+	requests := 0
+	ctx, cancel := context.WithCancel(context.Background())
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			cancel()
+			return &http.Response{StatusCode: 503}, nil
+		},
+	}
+	sut := port.HTTPPort{
+		HTTPClient:  &mockedHTTPClient,
+		BaseURL:     "https://test.com",
+		RetryPolicy: port.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://test.com", nil).WithContext(ctx)
+	res, err := sut.Do(req)
+
+	require.Nil(t, res)
+	assert.IsType(t, apierror.APIError{}, err)
+	assert.Equal(t, 1, requests)
+}
@@ -0,0 +1,138 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchArchive models a query for fetching all articles of a given month from the 'Archive' of the New York Times API.
+type FetchArchive struct {
+	Year  int
+	Month int
+}
+
+// FetchArchiveHandler is used to handle a FetchArchive query.
+type FetchArchiveHandler struct {
+	Query FetchArchive
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for an archive month from the New York Times API.
+// As a single month easily spans tens of megabytes, documents are decoded one by one from the response body
+// and passed on to handleDocument. Returning an error from handleDocument stops the decoding.
+func (h *FetchArchiveHandler) Handle(ctx context.Context, handleDocument func(nytapi.SearchDocument) error) error {
+	req, err := h.newFetchArchiveHTTPRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return err
+	}
+
+	return decodeFetchArchiveAPIResponse(ctx, res, handleDocument)
+}
+
+func (h *FetchArchiveHandler) newFetchArchiveHTTPRequest(ctx context.Context) (*http.Request, error) {
+	url := fmt.Sprintf("%v/archive/v1/%v/%v.json", h.Port.BaseURL, h.Query.Year, h.Query.Month)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchArchiveRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+// Walks the response {"copyright": ..., "response": {"meta": ..., "docs": [...]}} token by token,
+// so that only a single document is held in memory at any time.
+func decodeFetchArchiveAPIResponse(ctx context.Context, res *http.Response, handleDocument func(nytapi.SearchDocument) error) error {
+	if res.Body == nil {
+		return fmt.Errorf("no FetchArchiveAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	decoder := json.NewDecoder(res.Body)
+
+	return decodeArchiveObject(decoder, func(key string) error {
+		if key != "response" {
+			return skipArchiveValue(decoder)
+		}
+
+		return decodeArchiveObject(decoder, func(key string) error {
+			if key != "docs" {
+				return skipArchiveValue(decoder)
+			}
+
+			if err := expectArchiveDelim(decoder, '['); err != nil {
+				return err
+			}
+			for decoder.More() {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				var document nytapi.SearchDocument
+				if err := decoder.Decode(&document); err != nil {
+					return fmt.Errorf("unmarshaling an archive document failed with error: %v", err)
+				}
+				if err := handleDocument(document); err != nil {
+					return err
+				}
+			}
+			return expectArchiveDelim(decoder, ']')
+		})
+	})
+}
+
+// Decodes a JSON object, handing each key to decodeValue which is responsible for consuming the value.
+func decodeArchiveObject(decoder *json.Decoder, decodeValue func(key string) error) error {
+	if err := expectArchiveDelim(decoder, '{'); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return fmt.Errorf("unmarshaling the archive response failed with error: %v", err)
+		}
+		key, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("unmarshaling the archive response failed, unexpected token: %v", token)
+		}
+		if err := decodeValue(key); err != nil {
+			return err
+		}
+	}
+
+	return expectArchiveDelim(decoder, '}')
+}
+
+func expectArchiveDelim(decoder *json.Decoder, expected json.Delim) error {
+	token, err := decoder.Token()
+	if err == io.EOF {
+		return fmt.Errorf("unmarshaling the archive response failed, unexpected end of response")
+	}
+	if err != nil {
+		return fmt.Errorf("unmarshaling the archive response failed with error: %v", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != expected {
+		return fmt.Errorf("unmarshaling the archive response failed, expected %v but got: %v", expected, token)
+	}
+	return nil
+}
+
+func skipArchiveValue(decoder *json.Decoder) error {
+	var value json.RawMessage
+	if err := decoder.Decode(&value); err != nil {
+		return fmt.Errorf("unmarshaling the archive response failed with error: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+const fetchArchiveJSON = `{
+				"copyright": "Copyright (c) 2021 The New York Times Company. All Rights Reserved.",
+				"response": {
+					"meta": {
+						"hits": 2
+					},
+					"docs": [
+						{
+						"abstract": "Neil Armstrong and Edwin Aldrin land on the moon.",
+						"web_url": "https://www.nytimes.com/1969/07/21/archives/men-walk-on-moon.html",
+						"headline": {
+							"main": "MEN WALK ON MOON"
+						},
+						"keywords": [
+							{
+							"name": "subject",
+							"value": "Space",
+							"rank": 1,
+							"major": "N"
+							}
+						],
+						"pub_date": "1969-07-21T05:00:00+0000",
+						"_id": "nyt://article/00000000-0000-0000-0000-000000000001"
+						},
+						{
+						"abstract": "Astronauts return safely.",
+						"web_url": "https://www.nytimes.com/1969/07/25/archives/splashdown.html",
+						"headline": {
+							"main": "SPLASHDOWN"
+						},
+						"pub_date": "1969-07-25T05:00:00+0000",
+						"_id": "nyt://article/00000000-0000-0000-0000-000000000002"
+						}
+					]
+				}
+			}`
+
+func Test_FetchArchiveHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	body := ioutil.NopCloser(bytes.NewReader([]byte(fetchArchiveJSON)))
+
+	fetchArchive := query.FetchArchive{
+		Year:  1969,
+		Month: 7,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchArchiveHandler{
+		Query: fetchArchive,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	var documents []nytapi.SearchDocument
+	err := sut.Handle(ctx, func(document nytapi.SearchDocument) error {
+		documents = append(documents, document)
+		return nil
+	})
+
+	require.Nil(t, err)
+	if assert.Len(t, documents, 2) {
+		assert.Equal(t, "MEN WALK ON MOON", documents[0].Headline.Main)
+		assert.Equal(t, "SPLASHDOWN", documents[1].Headline.Main)
+	}
+}
+
+func Test_FetchArchiveHandler_StopsOnHandleDocumentError_WithError(t *testing.T) {
+	body := ioutil.NopCloser(bytes.NewReader([]byte(fetchArchiveJSON)))
+
+	fetchArchive := query.FetchArchive{
+		Year:  1969,
+		Month: 7,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchArchiveHandler{
+		Query: fetchArchive,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	handled := 0
+	stop := errors.New("stop")
+	err := sut.Handle(ctx, func(document nytapi.SearchDocument) error {
+		handled++
+		return stop
+	})
+
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 1, handled)
+}
+
+func Test_FetchArchiveHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	var cases = []struct {
+		json string
+	}{
+		{`totally not valid`},
+		{`{"response": {"docs": [{"headline": {"main": "cut off"`},
+		{`{"response": {"docs": {}}}`},
+		{`[]`},
+	}
+
+	for _, tt := range cases {
+		body := ioutil.NopCloser(bytes.NewReader([]byte(tt.json)))
+
+		fetchArchive := query.FetchArchive{
+			Year:  1969,
+			Month: 7,
+		}
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(*http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: 200, Body: body}, nil
+			},
+		}
+		mockedPort := port.HTTPPort{
+			HTTPClient: &mockedHTTPClient,
+			BaseURL:    "https://test-is-mocked.com",
+		}
+		sut := query.FetchArchiveHandler{
+			Query: fetchArchive,
+			Port:  mockedPort,
+		}
+		ctx := context.Background()
+
+		err := sut.Handle(ctx, func(document nytapi.SearchDocument) error {
+			return nil
+		})
+
+		assert.NotNil(t, err)
+	}
+}
+
+func Test_FetchArchiveHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchArchive := query.FetchArchive{
+		Year:  1969,
+		Month: 7,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchArchiveHandler{
+		Query: fetchArchive,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	err := sut.Handle(ctx, func(document nytapi.SearchDocument) error {
+		return nil
+	})
+
+	assert.NotNil(t, err)
+}
+
+func Test_FetchArchiveHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchArchive := query.FetchArchive{
+		Year:  1969,
+		Month: 7,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchArchiveHandler{
+		Query: fetchArchive,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	err := sut.Handle(ctx, func(document nytapi.SearchDocument) error {
+		return nil
+	})
+
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchArchiveHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchArchive := query.FetchArchive{
+		Year:  1969,
+		Month: 7,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchArchiveHandler{
+		Query: fetchArchive,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	err := sut.Handle(ctx, func(document nytapi.SearchDocument) error {
+		return nil
+	})
+
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
@@ -0,0 +1,108 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchBestsellerHistory models a query for fetching the bestseller history of books of the New York Times API.
+// Empty values are omitted from the request.
+type FetchBestsellerHistory struct {
+	Author    string
+	Title     string
+	Isbn      string
+	Publisher string
+	AgeGroup  string
+	Offset    int
+}
+
+// FetchBestsellerHistoryHandler is used to handle a FetchBestsellerHistory query.
+type FetchBestsellerHistoryHandler struct {
+	Query FetchBestsellerHistory
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for the bestseller history of books from the New York Times API.
+// Besides the results of the requested page the total number of results is returned.
+func (h *FetchBestsellerHistoryHandler) Handle(ctx context.Context) (*[]nytapi.BestsellerHistory, int, error) {
+	req, err := h.newFetchBestsellerHistoryHTTPRequest(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	apiResponse, err := newFetchBestsellerHistoryAPIResponse(res)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &apiResponse.Results, apiResponse.NumResults, nil
+}
+
+func (h *FetchBestsellerHistoryHandler) newFetchBestsellerHistoryHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	if h.Query.Author != "" {
+		params.Set("author", h.Query.Author)
+	}
+	if h.Query.Title != "" {
+		params.Set("title", h.Query.Title)
+	}
+	if h.Query.Isbn != "" {
+		params.Set("isbn", h.Query.Isbn)
+	}
+	if h.Query.Publisher != "" {
+		params.Set("publisher", h.Query.Publisher)
+	}
+	if h.Query.AgeGroup != "" {
+		params.Set("age-group", h.Query.AgeGroup)
+	}
+	params.Set("offset", strconv.Itoa(h.Query.Offset))
+
+	url := fmt.Sprintf("%v/books/v3/lists/best-sellers/history.json?%v", h.Port.BaseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchBestsellerHistoryRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type fetchBestsellerHistoryAPIResponse struct {
+	Status     string                     `json:"status,omitempty"`
+	Copyright  string                     `json:"copyright,omitempty"`
+	NumResults int                        `json:"num_results,omitempty"`
+	Results    []nytapi.BestsellerHistory `json:"results,omitempty"`
+}
+
+func newFetchBestsellerHistoryAPIResponse(res *http.Response) (*fetchBestsellerHistoryAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no FetchBestsellerHistoryAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(fetchBestsellerHistoryAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an FetchBestsellerHistoryResponse failed with error: %v", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,209 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchBestsellerHistoryHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 1,
+				"results": [
+					{
+					"title": "WHERE THE CRAWDADS SING",
+					"description": "In a quiet town on the North Carolina coast in 1969, a young woman who survived alone in the marsh becomes a murder suspect.",
+					"contributor": "by Delia Owens",
+					"author": "Delia Owens",
+					"contributor_note": "",
+					"price": "0.00",
+					"age_group": "",
+					"publisher": "Putnam",
+					"isbns": [
+						{
+						"isbn10": "0735219095",
+						"isbn13": "9780735219090"
+						}
+					],
+					"ranks_history": [
+						{
+						"primary_isbn10": "0735219095",
+						"primary_isbn13": "9780735219090",
+						"rank": 3,
+						"list_name": "Hardcover Fiction",
+						"display_name": "Hardcover Fiction",
+						"published_date": "2021-06-27",
+						"bestsellers_date": "2021-06-12",
+						"weeks_on_list": 141,
+						"ranks_last_week": null,
+						"asterisk": 0,
+						"dagger": 0
+						}
+					],
+					"reviews": [
+						{
+						"book_review_link": "",
+						"first_chapter_link": "",
+						"sunday_review_link": "",
+						"article_chapter_link": ""
+						}
+					]
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerHistory := query.FetchBestsellerHistory{
+		Author: "Delia Owens",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerHistoryHandler{
+		Query: fetchBestsellerHistory,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	history, numResults, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	assert.Equal(t, 1, numResults)
+	if assert.NotNil(t, history) && assert.Len(t, *history, 1) {
+		book := (*history)[0]
+		assert.Equal(t, "Delia Owens", book.Author)
+		if assert.Len(t, book.RanksHistory, 1) {
+			assert.Equal(t, 141, book.RanksHistory[0].WeeksOnList)
+		}
+	}
+}
+
+func Test_FetchBestsellerHistoryHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerHistory := query.FetchBestsellerHistory{
+		Author: "Delia Owens",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerHistoryHandler{
+		Query: fetchBestsellerHistory,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	history, numResults, err := sut.Handle(ctx)
+
+	require.Nil(t, history)
+	assert.Zero(t, numResults)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchBestsellerHistoryHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchBestsellerHistory := query.FetchBestsellerHistory{
+		Author: "Delia Owens",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerHistoryHandler{
+		Query: fetchBestsellerHistory,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	history, numResults, err := sut.Handle(ctx)
+
+	require.Nil(t, history)
+	assert.Zero(t, numResults)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchBestsellerHistoryHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchBestsellerHistory := query.FetchBestsellerHistory{
+		Author: "Delia Owens",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerHistoryHandler{
+		Query: fetchBestsellerHistory,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	history, numResults, err := sut.Handle(ctx)
+
+	require.Nil(t, history)
+	assert.Zero(t, numResults)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchBestsellerHistoryHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerHistory := query.FetchBestsellerHistory{
+		Author: "Delia Owens",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerHistoryHandler{
+		Query: fetchBestsellerHistory,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	history, numResults, err := sut.Handle(ctx)
+
+	require.Nil(t, history)
+	assert.Zero(t, numResults)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
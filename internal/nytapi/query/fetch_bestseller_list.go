@@ -0,0 +1,85 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchBestsellerList models a query for fetching a bestseller list of the New York Times API.
+// The date is either expected in the format YYYY-MM-DD or as 'current' for the latest list.
+type FetchBestsellerList struct {
+	ListName string
+	Date     string
+}
+
+// FetchBestsellerListHandler is used to handle a FetchBestsellerList query.
+type FetchBestsellerListHandler struct {
+	Query FetchBestsellerList
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for a bestseller list from the New York Times API.
+func (h *FetchBestsellerListHandler) Handle(ctx context.Context) (*nytapi.BestsellerList, error) {
+	req, err := h.newFetchBestsellerListHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := newFetchBestsellerListAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Results, nil
+}
+
+func (h *FetchBestsellerListHandler) newFetchBestsellerListHTTPRequest(ctx context.Context) (*http.Request, error) {
+	url := fmt.Sprintf("%v/books/v3/lists/%v/%v.json", h.Port.BaseURL, url.PathEscape(h.Query.Date), url.PathEscape(h.Query.ListName))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchBestsellerListRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type fetchBestsellerListAPIResponse struct {
+	Status       string                `json:"status,omitempty"`
+	Copyright    string                `json:"copyright,omitempty"`
+	NumResults   int                   `json:"num_results,omitempty"`
+	LastModified string                `json:"last_modified,omitempty"`
+	Results      nytapi.BestsellerList `json:"results,omitempty"`
+}
+
+func newFetchBestsellerListAPIResponse(res *http.Response) (*fetchBestsellerListAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no FetchBestsellerListAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(fetchBestsellerListAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an FetchBestsellerListResponse failed with error: %v", err)
+	}
+
+	return response, nil
+}
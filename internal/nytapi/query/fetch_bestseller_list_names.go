@@ -0,0 +1,79 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchBestsellerListNames models a query for fetching the names of all bestseller lists of the New York Times API.
+type FetchBestsellerListNames struct{}
+
+// FetchBestsellerListNamesHandler is used to handle a FetchBestsellerListNames query.
+type FetchBestsellerListNamesHandler struct {
+	Query FetchBestsellerListNames
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for the names of all bestseller lists from the New York Times API.
+func (h *FetchBestsellerListNamesHandler) Handle(ctx context.Context) (*[]nytapi.BestsellerListName, error) {
+	req, err := h.newFetchBestsellerListNamesHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := newFetchBestsellerListNamesAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Results, nil
+}
+
+func (h *FetchBestsellerListNamesHandler) newFetchBestsellerListNamesHTTPRequest(ctx context.Context) (*http.Request, error) {
+	url := fmt.Sprintf("%v/books/v3/lists/names.json", h.Port.BaseURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchBestsellerListNamesRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type fetchBestsellerListNamesAPIResponse struct {
+	Status     string                      `json:"status,omitempty"`
+	Copyright  string                      `json:"copyright,omitempty"`
+	NumResults int                         `json:"num_results,omitempty"`
+	Results    []nytapi.BestsellerListName `json:"results,omitempty"`
+}
+
+func newFetchBestsellerListNamesAPIResponse(res *http.Response) (*fetchBestsellerListNamesAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no FetchBestsellerListNamesAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(fetchBestsellerListNamesAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an FetchBestsellerListNamesResponse failed with error: %v", err)
+	}
+
+	return response, nil
+}
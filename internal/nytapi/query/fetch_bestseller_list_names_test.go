@@ -0,0 +1,170 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchBestsellerListNamesHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 2,
+				"results": [
+					{
+					"list_name": "Combined Print and E-Book Fiction",
+					"display_name": "Combined Print & E-Book Fiction",
+					"list_name_encoded": "combined-print-and-e-book-fiction",
+					"oldest_published_date": "2011-02-13",
+					"newest_published_date": "2021-06-27",
+					"updated": "WEEKLY"
+					},
+					{
+					"list_name": "Hardcover Fiction",
+					"display_name": "Hardcover Fiction",
+					"list_name_encoded": "hardcover-fiction",
+					"oldest_published_date": "2008-06-08",
+					"newest_published_date": "2021-06-27",
+					"updated": "WEEKLY"
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerListNames := query.FetchBestsellerListNames{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerListNamesHandler{
+		Query: fetchBestsellerListNames,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	listNames, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	assert.NotNil(t, listNames)
+	if assert.NotNil(t, listNames) && assert.Len(t, *listNames, 2) {
+		assert.Equal(t, "hardcover-fiction", (*listNames)[1].ListNameEncoded)
+		assert.Equal(t, "2008-06-08", (*listNames)[1].OldestPublishedDate)
+		assert.Equal(t, "WEEKLY", (*listNames)[1].Updated)
+	}
+}
+
+func Test_FetchBestsellerListNamesHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerListNames := query.FetchBestsellerListNames{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerListNamesHandler{
+		Query: fetchBestsellerListNames,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	listNames, err := sut.Handle(ctx)
+
+	require.Nil(t, listNames)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchBestsellerListNamesHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchBestsellerListNames := query.FetchBestsellerListNames{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerListNamesHandler{
+		Query: fetchBestsellerListNames,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	listNames, err := sut.Handle(ctx)
+
+	require.Nil(t, listNames)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchBestsellerListNamesHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchBestsellerListNames := query.FetchBestsellerListNames{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerListNamesHandler{
+		Query: fetchBestsellerListNames,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	listNames, err := sut.Handle(ctx)
+
+	require.Nil(t, listNames)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchBestsellerListNamesHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerListNames := query.FetchBestsellerListNames{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerListNamesHandler{
+		Query: fetchBestsellerListNames,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	listNames, err := sut.Handle(ctx)
+
+	require.Nil(t, listNames)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
@@ -0,0 +1,223 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchBestsellerListHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 1,
+				"last_modified": "2021-06-16T22:12:02-04:00",
+				"results": {
+					"list_name": "Hardcover Fiction",
+					"list_name_encoded": "hardcover-fiction",
+					"bestsellers_date": "2021-06-12",
+					"published_date": "2021-06-27",
+					"published_date_description": "latest",
+					"next_published_date": "",
+					"previous_published_date": "2021-06-20",
+					"display_name": "Hardcover Fiction",
+					"normal_list_ends_at": 15,
+					"updated": "WEEKLY",
+					"books": [
+						{
+						"rank": 1,
+						"rank_last_week": 2,
+						"weeks_on_list": 3,
+						"asterisk": 0,
+						"dagger": 0,
+						"primary_isbn10": "1538719843",
+						"primary_isbn13": "9781538719848",
+						"publisher": "Grand Central",
+						"description": "Lt. Col. Hank Alexander and his wife fight a conspiracy that threatens the nation.",
+						"price": "0.00",
+						"title": "THE CHRISTMAS PIG",
+						"author": "J.K. Rowling",
+						"contributor": "by J.K. Rowling",
+						"contributor_note": "",
+						"book_image": "https://storage.googleapis.com/du-prd/books/images/9781538719848.jpg",
+						"book_image_width": 331,
+						"book_image_height": 500,
+						"amazon_product_url": "https://www.amazon.com/dp/1538719843?tag=NYTBSREV-20",
+						"age_group": "",
+						"book_review_link": "",
+						"first_chapter_link": "",
+						"sunday_review_link": "",
+						"article_chapter_link": "",
+						"isbns": [
+							{
+							"isbn10": "1538719843",
+							"isbn13": "9781538719848"
+							}
+						],
+						"buy_links": [
+							{
+							"name": "Amazon",
+							"url": "https://www.amazon.com/dp/1538719843?tag=NYTBSREV-20"
+							}
+						],
+						"book_uri": "nyt://book/00000000-0000-0000-0000-000000000000"
+						}
+					],
+					"corrections": []
+				}
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerList := query.FetchBestsellerList{
+		ListName: "hardcover-fiction",
+		Date:     "current",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerListHandler{
+		Query: fetchBestsellerList,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	bestsellerList, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, bestsellerList) && assert.Len(t, bestsellerList.Books, 1) {
+		book := bestsellerList.Books[0]
+		assert.Equal(t, 1, book.Rank)
+		assert.Equal(t, 2, book.RankLastWeek)
+		assert.Equal(t, 3, book.WeeksOnList)
+		assert.Len(t, book.Isbns, 1)
+		assert.Len(t, book.BuyLinks, 1)
+	}
+}
+
+func Test_FetchBestsellerListHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerList := query.FetchBestsellerList{
+		ListName: "hardcover-fiction",
+		Date:     "current",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerListHandler{
+		Query: fetchBestsellerList,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	bestsellerList, err := sut.Handle(ctx)
+
+	require.Nil(t, bestsellerList)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchBestsellerListHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchBestsellerList := query.FetchBestsellerList{
+		ListName: "hardcover-fiction",
+		Date:     "current",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerListHandler{
+		Query: fetchBestsellerList,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	bestsellerList, err := sut.Handle(ctx)
+
+	require.Nil(t, bestsellerList)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchBestsellerListHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchBestsellerList := query.FetchBestsellerList{
+		ListName: "hardcover-fiction",
+		Date:     "current",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerListHandler{
+		Query: fetchBestsellerList,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	bestsellerList, err := sut.Handle(ctx)
+
+	require.Nil(t, bestsellerList)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchBestsellerListHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerList := query.FetchBestsellerList{
+		ListName: "hardcover-fiction",
+		Date:     "current",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerListHandler{
+		Query: fetchBestsellerList,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	bestsellerList, err := sut.Handle(ctx)
+
+	require.Nil(t, bestsellerList)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
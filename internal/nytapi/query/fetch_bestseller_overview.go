@@ -0,0 +1,88 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchBestsellerOverview models a query for fetching the overview of all bestseller lists of the New York Times API.
+// The published date is expected in the format YYYY-MM-DD, an empty value fetches the latest overview.
+type FetchBestsellerOverview struct {
+	PublishedDate string
+}
+
+// FetchBestsellerOverviewHandler is used to handle a FetchBestsellerOverview query.
+type FetchBestsellerOverviewHandler struct {
+	Query FetchBestsellerOverview
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for the overview of all bestseller lists from the New York Times API.
+func (h *FetchBestsellerOverviewHandler) Handle(ctx context.Context) (*nytapi.BestsellerOverview, error) {
+	req, err := h.newFetchBestsellerOverviewHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := newFetchBestsellerOverviewAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Results, nil
+}
+
+func (h *FetchBestsellerOverviewHandler) newFetchBestsellerOverviewHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	if h.Query.PublishedDate != "" {
+		params.Set("published_date", h.Query.PublishedDate)
+	}
+
+	url := fmt.Sprintf("%v/books/v3/lists/overview.json?%v", h.Port.BaseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchBestsellerOverviewRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type fetchBestsellerOverviewAPIResponse struct {
+	Status     string                    `json:"status,omitempty"`
+	Copyright  string                    `json:"copyright,omitempty"`
+	NumResults int                       `json:"num_results,omitempty"`
+	Results    nytapi.BestsellerOverview `json:"results,omitempty"`
+}
+
+func newFetchBestsellerOverviewAPIResponse(res *http.Response) (*fetchBestsellerOverviewAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no FetchBestsellerOverviewAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(fetchBestsellerOverviewAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an FetchBestsellerOverviewResponse failed with error: %v", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,206 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchBestsellerOverviewHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 2,
+				"results": {
+					"bestsellers_date": "2021-06-12",
+					"published_date": "2021-06-27",
+					"published_date_description": "latest",
+					"previous_published_date": "2021-06-20",
+					"next_published_date": "",
+					"lists": [
+						{
+						"list_id": 704,
+						"list_name": "Combined Print and E-Book Fiction",
+						"list_name_encoded": "combined-print-and-e-book-fiction",
+						"display_name": "Combined Print & E-Book Fiction",
+						"updated": "WEEKLY",
+						"list_image": null,
+						"list_image_width": null,
+						"list_image_height": null,
+						"books": [
+							{
+							"rank": 1,
+							"rank_last_week": 0,
+							"weeks_on_list": 1,
+							"price": "0.00",
+							"title": "THE LAST THING HE TOLD ME",
+							"author": "Laura Dave",
+							"primary_isbn13": "9781501171345",
+							"amazon_product_url": "https://www.amazon.com/dp/1501171348?tag=NYTBSREV-20",
+							"buy_links": [
+								{
+								"name": "Amazon",
+								"url": "https://www.amazon.com/dp/1501171348?tag=NYTBSREV-20"
+								}
+							]
+							}
+						]
+						},
+						{
+						"list_id": 1,
+						"list_name": "Hardcover Fiction",
+						"list_name_encoded": "hardcover-fiction",
+						"display_name": "Hardcover Fiction",
+						"updated": "WEEKLY",
+						"books": []
+						}
+					]
+				}
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerOverview := query.FetchBestsellerOverview{
+		PublishedDate: "2021-06-27",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerOverviewHandler{
+		Query: fetchBestsellerOverview,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	overview, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	assert.NotNil(t, overview)
+	if assert.NotNil(t, overview) && assert.Len(t, overview.Lists, 2) {
+		assert.Equal(t, "combined-print-and-e-book-fiction", overview.Lists[0].ListNameEncoded)
+		assert.Len(t, overview.Lists[0].Books, 1)
+	}
+}
+
+func Test_FetchBestsellerOverviewHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerOverview := query.FetchBestsellerOverview{
+		PublishedDate: "2021-06-27",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerOverviewHandler{
+		Query: fetchBestsellerOverview,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	overview, err := sut.Handle(ctx)
+
+	require.Nil(t, overview)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchBestsellerOverviewHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchBestsellerOverview := query.FetchBestsellerOverview{
+		PublishedDate: "2021-06-27",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerOverviewHandler{
+		Query: fetchBestsellerOverview,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	overview, err := sut.Handle(ctx)
+
+	require.Nil(t, overview)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchBestsellerOverviewHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchBestsellerOverview := query.FetchBestsellerOverview{
+		PublishedDate: "2021-06-27",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerOverviewHandler{
+		Query: fetchBestsellerOverview,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	overview, err := sut.Handle(ctx)
+
+	require.Nil(t, overview)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchBestsellerOverviewHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchBestsellerOverview := query.FetchBestsellerOverview{
+		PublishedDate: "2021-06-27",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchBestsellerOverviewHandler{
+		Query: fetchBestsellerOverview,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	overview, err := sut.Handle(ctx)
+
+	require.Nil(t, overview)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
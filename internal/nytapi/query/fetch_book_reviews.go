@@ -45,7 +45,7 @@ func (h *FetchBookReviewsHandler) Handle(ctx context.Context) (*[]nytapi.BookRev
 }
 
 func (h *FetchBookReviewsHandler) newFetchBookReviewsHTTPRequest(ctx context.Context) (*http.Request, error) {
-	url := fmt.Sprintf("%v/books/v3/reviews.json?%v=%v&api-key=%v", h.Port.BaseURL, h.Query.Category, url.QueryEscape(h.Query.Term), h.Port.APIKey)
+	url := fmt.Sprintf("%v/books/v3/reviews.json?%v=%v", h.Port.BaseURL, h.Query.Category, url.QueryEscape(h.Query.Term))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -49,7 +49,6 @@ func Test_FetchBookReviewsHandler_HandlesSuccessResponseWithBody_WithValue(t *te
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchBookReviewsHandler{
 		Query: fetchBookReviews,
@@ -79,7 +78,6 @@ func Test_FetchBookReviewsHandler_HandlesSuccessResponseWithInvalidBody_WithErro
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchBookReviewsHandler{
 		Query: fetchBookReviews,
@@ -105,7 +103,6 @@ func Test_FetchBookReviewsHandler_HandlesSuccessResponseWithoutBody_WithError(t
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchBookReviewsHandler{
 		Query: fetchBookReviews,
@@ -132,7 +129,6 @@ func Test_FetchBookReviewsHandler_HandlesFailureResponse_WithError(t *testing.T)
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchBookReviewsHandler{
 		Query: fetchBookReviews,
@@ -165,7 +161,6 @@ func Test_FetchBookReviewsHandler_HandlesFailureResponseBody_WithError(t *testin
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchBookReviewsHandler{
 		Query: fetchBookReviews,
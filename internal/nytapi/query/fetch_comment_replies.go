@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchCommentReplies models a query for fetching a page of replies to a comment from the 'Community' New York Times API.
+type FetchCommentReplies struct {
+	URL             string
+	CommentSequence int
+	Offset          int
+}
+
+// FetchCommentRepliesHandler is used to handle a FetchCommentReplies query.
+type FetchCommentRepliesHandler struct {
+	Query FetchCommentReplies
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for replies to a comment from the New York Times API.
+func (h *FetchCommentRepliesHandler) Handle(ctx context.Context) (*[]nytapi.Comment, *nytapi.CommentsMeta, error) {
+	req, err := h.newFetchCommentRepliesHTTPRequest(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	apiResponse, err := newCommentsAPIResponse(res)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The API answers with the parent comment, holding the requested page of replies.
+	comments := apiResponse.Results.Comments
+	if len(comments) == 1 && comments[0].CommentSequence == h.Query.CommentSequence {
+		comments = comments[0].Replies
+		if comments == nil {
+			comments = []nytapi.Comment{}
+		}
+	}
+
+	return &comments, &apiResponse.Results.CommentsMeta, nil
+}
+
+func (h *FetchCommentRepliesHandler) newFetchCommentRepliesHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	params.Set("url", h.Query.URL)
+	params.Set("commentSequence", strconv.Itoa(h.Query.CommentSequence))
+	params.Set("offset", strconv.Itoa(h.Query.Offset))
+
+	url := fmt.Sprintf("%v/community/v3/user-content/replies.json?%v", h.Port.BaseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchCommentRepliesRequest with error: %v", err)
+	}
+
+	return req, nil
+}
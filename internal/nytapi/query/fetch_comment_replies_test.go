@@ -0,0 +1,208 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchCommentRepliesHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company. All Rights Reserved.",
+				"results": {
+					"comments": [
+						{
+						"commentID": 113245678,
+						"commentSequence": 113245678,
+						"userDisplayName": "Jane",
+						"replyCount": 5,
+						"replies": [
+							{
+							"commentID": 113246001,
+							"commentSequence": 113246001,
+							"userDisplayName": "Max",
+							"commentBody": "Fourth reply.",
+							"parentID": 113245678,
+							"depth": 2
+							},
+							{
+							"commentID": 113246002,
+							"commentSequence": 113246002,
+							"userDisplayName": "Eva",
+							"commentBody": "Fifth reply.",
+							"parentID": 113245678,
+							"depth": 2
+							}
+						],
+						"depth": 1
+						}
+					],
+					"totalCommentsFound": 5,
+					"totalReplyCommentsFound": 5,
+					"totalReplyCommentsReturned": 2
+				}
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchCommentReplies := query.FetchCommentReplies{
+		URL:             "https://www.nytimes.com/2021/06/24/science/mars-rover.html",
+		CommentSequence: 113245678,
+		Offset:          3,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCommentRepliesHandler{
+		Query: fetchCommentReplies,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	replies, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, replies) && assert.Len(t, *replies, 2) {
+		assert.Equal(t, "Max", (*replies)[0].UserDisplayName)
+		assert.Equal(t, 113245678, (*replies)[1].ParentID)
+	}
+	if assert.NotNil(t, meta) {
+		assert.Equal(t, 5, meta.TotalReplyCommentsFound)
+	}
+}
+
+func Test_FetchCommentRepliesHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchCommentReplies := query.FetchCommentReplies{
+		URL:             "https://www.nytimes.com/2021/06/24/science/mars-rover.html",
+		CommentSequence: 113245678,
+		Offset:          3,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCommentRepliesHandler{
+		Query: fetchCommentReplies,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	replies, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, replies)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchCommentRepliesHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchCommentReplies := query.FetchCommentReplies{
+		URL:             "https://www.nytimes.com/2021/06/24/science/mars-rover.html",
+		CommentSequence: 113245678,
+		Offset:          3,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCommentRepliesHandler{
+		Query: fetchCommentReplies,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	replies, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, replies)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchCommentRepliesHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchCommentReplies := query.FetchCommentReplies{
+		URL:             "https://www.nytimes.com/2021/06/24/science/mars-rover.html",
+		CommentSequence: 113245678,
+		Offset:          3,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCommentRepliesHandler{
+		Query: fetchCommentReplies,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	replies, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, replies)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchCommentRepliesHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchCommentReplies := query.FetchCommentReplies{
+		URL:             "https://www.nytimes.com/2021/06/24/science/mars-rover.html",
+		CommentSequence: 113245678,
+		Offset:          3,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCommentRepliesHandler{
+		Query: fetchCommentReplies,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	replies, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, replies)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
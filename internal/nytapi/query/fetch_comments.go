@@ -0,0 +1,97 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchComments models a query for fetching a page of comments on an article from the 'Community' New York Times API.
+type FetchComments struct {
+	URL    string
+	Offset int
+	Sort   string
+}
+
+// FetchCommentsHandler is used to handle a FetchComments query.
+type FetchCommentsHandler struct {
+	Query FetchComments
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for comments on an article from the New York Times API.
+func (h *FetchCommentsHandler) Handle(ctx context.Context) (*[]nytapi.Comment, *nytapi.CommentsMeta, error) {
+	req, err := h.newFetchCommentsHTTPRequest(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	apiResponse, err := newCommentsAPIResponse(res)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &apiResponse.Results.Comments, &apiResponse.Results.CommentsMeta, nil
+}
+
+func (h *FetchCommentsHandler) newFetchCommentsHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	params.Set("url", h.Query.URL)
+	params.Set("offset", strconv.Itoa(h.Query.Offset))
+	if h.Query.Sort != "" {
+		params.Set("sort", h.Query.Sort)
+	}
+
+	url := fmt.Sprintf("%v/community/v3/user-content/url.json?%v", h.Port.BaseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchCommentsRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type commentsAPIResponse struct {
+	Status    string `json:"status,omitempty"`
+	Copyright string `json:"copyright,omitempty"`
+	Results   struct {
+		Comments []nytapi.Comment `json:"comments,omitempty"`
+		nytapi.CommentsMeta
+	} `json:"results,omitempty"`
+}
+
+func newCommentsAPIResponse(res *http.Response) (*commentsAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no CommentsAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(commentsAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an CommentsResponse failed with error: %v", err)
+	}
+	if response.Results.Comments == nil {
+		response.Results.Comments = []nytapi.Comment{}
+	}
+
+	return response, nil
+}
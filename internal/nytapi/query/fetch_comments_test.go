@@ -0,0 +1,230 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchCommentsHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company. All Rights Reserved.",
+				"results": {
+					"api_timestamp": "1624600000",
+					"comments": [
+						{
+						"commentID": 113245678,
+						"status": "approved",
+						"commentSequence": 113245678,
+						"userID": 12345,
+						"userDisplayName": "Jane",
+						"userLocation": "Brooklyn",
+						"userTitle": null,
+						"commentBody": "Fascinating read.",
+						"createDate": "1624560000",
+						"recommendations": 42,
+						"replyCount": 1,
+						"replies": [
+							{
+							"commentID": 113245999,
+							"commentSequence": 113245999,
+							"userDisplayName": "John",
+							"userLocation": "Denver",
+							"commentBody": "Agreed.",
+							"recommendations": 3,
+							"replyCount": 0,
+							"replies": [],
+							"editorsSelection": false,
+							"parentID": 113245678,
+							"parentUserDisplayName": "Jane",
+							"depth": 2
+							}
+						],
+						"editorsSelection": true,
+						"parentID": null,
+						"depth": 1,
+						"commentType": "comment"
+						}
+					],
+					"totalCommentsFound": 27,
+					"totalCommentsReturned": 2,
+					"totalParentCommentsFound": 26,
+					"totalParentCommentsReturned": 1,
+					"totalReplyCommentsFound": 1,
+					"totalReplyCommentsReturned": 1,
+					"totalEditorsSelectionFound": 1,
+					"depthLimit": 2
+				}
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchComments := query.FetchComments{
+		URL:    "https://www.nytimes.com/2021/06/24/science/mars-rover.html",
+		Offset: 25,
+		Sort:   "newest",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCommentsHandler{
+		Query: fetchComments,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	comments, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, comments) && assert.Len(t, *comments, 1) {
+		comment := (*comments)[0]
+		assert.Equal(t, 113245678, comment.CommentSequence)
+		assert.Equal(t, "Brooklyn", comment.UserLocation)
+		assert.Equal(t, 42, comment.Recommendations)
+		assert.True(t, comment.EditorsSelection)
+		if assert.Len(t, comment.Replies, 1) {
+			assert.Equal(t, "Jane", comment.Replies[0].ParentUserDisplayName)
+			assert.Equal(t, 2, comment.Replies[0].Depth)
+		}
+	}
+	if assert.NotNil(t, meta) {
+		assert.Equal(t, 26, meta.TotalParentCommentsFound)
+		assert.Equal(t, 2, meta.DepthLimit)
+	}
+}
+
+func Test_FetchCommentsHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchComments := query.FetchComments{
+		URL:    "https://www.nytimes.com/2021/06/24/science/mars-rover.html",
+		Offset: 25,
+		Sort:   "newest",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCommentsHandler{
+		Query: fetchComments,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	comments, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, comments)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchCommentsHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchComments := query.FetchComments{
+		URL:    "https://www.nytimes.com/2021/06/24/science/mars-rover.html",
+		Offset: 25,
+		Sort:   "newest",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCommentsHandler{
+		Query: fetchComments,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	comments, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, comments)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchCommentsHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchComments := query.FetchComments{
+		URL:    "https://www.nytimes.com/2021/06/24/science/mars-rover.html",
+		Offset: 25,
+		Sort:   "newest",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCommentsHandler{
+		Query: fetchComments,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	comments, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, comments)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchCommentsHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchComments := query.FetchComments{
+		URL:    "https://www.nytimes.com/2021/06/24/science/mars-rover.html",
+		Offset: 25,
+		Sort:   "newest",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCommentsHandler{
+		Query: fetchComments,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	comments, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, comments)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchConcept models a query for fetching a single concept by type and name of the 'Semantic' New York Times API.
+// Empty fields are omitted from the request, delivering only the basic description of the concept.
+type FetchConcept struct {
+	ConceptType     string
+	SpecificConcept string
+	Fields          []string
+}
+
+// FetchConceptHandler is used to handle a FetchConcept query.
+type FetchConceptHandler struct {
+	Query FetchConcept
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for a concept from the New York Times API.
+func (h *FetchConceptHandler) Handle(ctx context.Context) (*[]nytapi.Concept, error) {
+	req, err := h.newFetchConceptHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := newConceptsAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Results, nil
+}
+
+func (h *FetchConceptHandler) newFetchConceptHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	if len(h.Query.Fields) > 0 {
+		params.Set("fields", strings.Join(h.Query.Fields, ","))
+	}
+
+	url := fmt.Sprintf("%v/semantic/v2/concept/name/%v/%v.json?%v", h.Port.BaseURL, url.PathEscape(h.Query.ConceptType), url.PathEscape(h.Query.SpecificConcept), params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchConceptRequest with error: %v", err)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,242 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchConceptHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 1,
+				"results": [
+					{
+					"concept_id": 16917,
+					"concept_name": "Paris (France)",
+					"concept_status": "Active",
+					"is_times_tag": 1,
+					"concept_type": "nytd_geo",
+					"search_api_query": "geo_facet:[Paris (France)]",
+					"links": [
+						{
+						"concept_id": 16917,
+						"link_id": 30144,
+						"relation": "sameAs",
+						"link": "http://sws.geonames.org/2988507/",
+						"link_type": "geonames_uri",
+						"mapping_type": "manual"
+						}
+					],
+					"ancestors": [
+						{
+						"concept_id": 16752,
+						"concept_name": "France",
+						"concept_type": "nytd_geo",
+						"concept_status": "Active",
+						"is_times_tag": 1
+						}
+					],
+					"geocodes": [
+						{
+						"geocode_id": 2988507,
+						"concept_id": 16917,
+						"name": "Paris",
+						"latitude": 48.85341,
+						"longitude": 2.3488,
+						"elevation": 0,
+						"population": 2138551,
+						"country_code": "FR",
+						"country_name": "France",
+						"feature_class": "P",
+						"feature_code": "PPLC",
+						"time_zone_id": "Europe/Paris"
+						}
+					],
+					"article_list": {
+						"results": [
+							{
+							"body": "The French capital reopened its museums.",
+							"byline": "By Aurelien Breeden",
+							"date": "20210701",
+							"title": "Paris Museums Reopen",
+							"url": "https://www.nytimes.com/2021/07/01/world/europe/paris-museums-reopen.html",
+							"concepts": {
+								"nytd_geo": ["Paris (France)"]
+							}
+							}
+						],
+						"total": 1
+					}
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchConcept := query.FetchConcept{
+		ConceptType:     "nytd_geo",
+		SpecificConcept: "Paris (France)",
+		Fields:          []string{"links", "geocodes", "article_list"},
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchConceptHandler{
+		Query: fetchConcept,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	concepts, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, concepts) && assert.Len(t, *concepts, 1) {
+		concept := (*concepts)[0]
+		assert.Equal(t, "Paris (France)", concept.ConceptName)
+		if assert.Len(t, concept.Links, 1) {
+			assert.Equal(t, "http://sws.geonames.org/2988507/", concept.Links[0].Link)
+		}
+		if assert.Len(t, concept.Ancestors, 1) {
+			assert.Equal(t, "France", concept.Ancestors[0].ConceptName)
+		}
+		if assert.Len(t, concept.Geocodes, 1) {
+			assert.Equal(t, "48.85341", concept.Geocodes[0].Latitude.String())
+		}
+		if assert.NotNil(t, concept.ArticleList) && assert.Len(t, concept.ArticleList.Results, 1) {
+			assert.Equal(t, "Paris Museums Reopen", concept.ArticleList.Results[0].Title)
+		}
+	}
+}
+
+func Test_FetchConceptHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchConcept := query.FetchConcept{
+		ConceptType:     "nytd_geo",
+		SpecificConcept: "Paris (France)",
+		Fields:          []string{"links", "geocodes", "article_list"},
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchConceptHandler{
+		Query: fetchConcept,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	concepts, err := sut.Handle(ctx)
+
+	require.Nil(t, concepts)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchConceptHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchConcept := query.FetchConcept{
+		ConceptType:     "nytd_geo",
+		SpecificConcept: "Paris (France)",
+		Fields:          []string{"links", "geocodes", "article_list"},
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchConceptHandler{
+		Query: fetchConcept,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	concepts, err := sut.Handle(ctx)
+
+	require.Nil(t, concepts)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchConceptHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchConcept := query.FetchConcept{
+		ConceptType:     "nytd_geo",
+		SpecificConcept: "Paris (France)",
+		Fields:          []string{"links", "geocodes", "article_list"},
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchConceptHandler{
+		Query: fetchConcept,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	concepts, err := sut.Handle(ctx)
+
+	require.Nil(t, concepts)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchConceptHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchConcept := query.FetchConcept{
+		ConceptType:     "nytd_geo",
+		SpecificConcept: "Paris (France)",
+		Fields:          []string{"links", "geocodes", "article_list"},
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchConceptHandler{
+		Query: fetchConcept,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	concepts, err := sut.Handle(ctx)
+
+	require.Nil(t, concepts)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
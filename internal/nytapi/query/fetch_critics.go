@@ -0,0 +1,83 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchCritics models a query for fetching movie critics of the New York Times API.
+// The reviewer is either one of 'all', 'full-time', 'part-time' or the name of a single critic.
+type FetchCritics struct {
+	Reviewer string
+}
+
+// FetchCriticsHandler is used to handle a FetchCritics query.
+type FetchCriticsHandler struct {
+	Query FetchCritics
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for movie critics from the New York Times API.
+func (h *FetchCriticsHandler) Handle(ctx context.Context) (*[]nytapi.Critic, error) {
+	req, err := h.newFetchCriticsHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := newFetchCriticsAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Results, nil
+}
+
+func (h *FetchCriticsHandler) newFetchCriticsHTTPRequest(ctx context.Context) (*http.Request, error) {
+	url := fmt.Sprintf("%v/movies/v2/critics/%v.json", h.Port.BaseURL, url.PathEscape(h.Query.Reviewer))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchCriticsRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type fetchCriticsAPIResponse struct {
+	Status     string          `json:"status,omitempty"`
+	Copyright  string          `json:"copyright,omitempty"`
+	NumResults int             `json:"num_results,omitempty"`
+	Results    []nytapi.Critic `json:"results,omitempty"`
+}
+
+func newFetchCriticsAPIResponse(res *http.Response) (*fetchCriticsAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no FetchCriticsAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(fetchCriticsAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an FetchCriticsResponse failed with error: %v", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchCriticsPicks models a query for fetching the movie reviews marked as critics' picks of the New York Times API.
+type FetchCriticsPicks struct {
+	Order  string
+	Offset int
+}
+
+// FetchCriticsPicksHandler is used to handle a FetchCriticsPicks query.
+type FetchCriticsPicksHandler struct {
+	Query FetchCriticsPicks
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for critics' picks from the New York Times API.
+// Besides the reviews it is returned whether further results are available beyond this page.
+func (h *FetchCriticsPicksHandler) Handle(ctx context.Context) (*[]nytapi.MovieReview, bool, error) {
+	req, err := h.newFetchCriticsPicksHTTPRequest(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+
+	apiResponse, err := newMovieReviewsAPIResponse(res)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &apiResponse.Results, apiResponse.HasMore, nil
+}
+
+func (h *FetchCriticsPicksHandler) newFetchCriticsPicksHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	if h.Query.Order != "" {
+		params.Set("order", h.Query.Order)
+	}
+	params.Set("offset", strconv.Itoa(h.Query.Offset))
+
+	url := fmt.Sprintf("%v/movies/v2/reviews/picks.json?%v", h.Port.BaseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchCriticsPicksRequest with error: %v", err)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,183 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchCriticsHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company. All Rights Reserved.",
+				"num_results": 1,
+				"results": [
+					{
+					"display_name": "A. O. Scott",
+					"sort_name": "A. O. Scott",
+					"status": "full-time",
+					"bio": "A. O. Scott joined The New York Times as a film critic in January 2000.",
+					"seo_name": "A-O-Scott",
+					"multimedia": {
+						"resource": {
+						"type": "image",
+						"src": "https://static01.nyt.com/images/2014/03/31/movies/AO-SCOTT/AO-SCOTT-articleInline.jpg",
+						"height": 140,
+						"width": 140,
+						"credit": "Earl Wilson/\u003cbr/\u003eThe New York Times"
+						}
+					}
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchCritics := query.FetchCritics{
+		Reviewer: "A. O. Scott",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCriticsHandler{
+		Query: fetchCritics,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	critics, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	assert.NotNil(t, critics)
+	if assert.NotNil(t, critics) && assert.Len(t, *critics, 1) {
+		critic := (*critics)[0]
+		assert.Equal(t, "A. O. Scott", critic.DisplayName)
+		assert.Equal(t, "full-time", critic.Status)
+		if assert.NotNil(t, critic.Multimedia) {
+			assert.Equal(t, "image", critic.Multimedia.Resource.Type)
+		}
+	}
+}
+
+func Test_FetchCriticsHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchCritics := query.FetchCritics{
+		Reviewer: "A. O. Scott",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCriticsHandler{
+		Query: fetchCritics,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	critics, err := sut.Handle(ctx)
+
+	require.Nil(t, critics)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchCriticsHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchCritics := query.FetchCritics{
+		Reviewer: "A. O. Scott",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCriticsHandler{
+		Query: fetchCritics,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	critics, err := sut.Handle(ctx)
+
+	require.Nil(t, critics)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchCriticsHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchCritics := query.FetchCritics{
+		Reviewer: "A. O. Scott",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCriticsHandler{
+		Query: fetchCritics,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	critics, err := sut.Handle(ctx)
+
+	require.Nil(t, critics)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchCriticsHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchCritics := query.FetchCritics{
+		Reviewer: "A. O. Scott",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchCriticsHandler{
+		Query: fetchCritics,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	critics, err := sut.Handle(ctx)
+
+	require.Nil(t, critics)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
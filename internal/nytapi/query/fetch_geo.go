@@ -0,0 +1,93 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchGeo models a query for looking up places of the 'Geographic' New York Times API.
+// A limit of 0 leaves the number of places to the API.
+type FetchGeo struct {
+	Query string
+	Limit int
+}
+
+// FetchGeoHandler is used to handle a FetchGeo query.
+type FetchGeoHandler struct {
+	Query FetchGeo
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for places from the New York Times API.
+func (h *FetchGeoHandler) Handle(ctx context.Context) (*[]nytapi.Geo, error) {
+	req, err := h.newFetchGeoHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := newFetchGeoAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Results, nil
+}
+
+func (h *FetchGeoHandler) newFetchGeoHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	params.Set("query", h.Query.Query)
+	if h.Query.Limit > 0 {
+		params.Set("limit", strconv.Itoa(h.Query.Limit))
+	}
+
+	url := fmt.Sprintf("%v/semantic/v2/geocodes/query.json?%v", h.Port.BaseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchGeoRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type fetchGeoAPIResponse struct {
+	Status    string       `json:"status,omitempty"`
+	Copyright string       `json:"copyright,omitempty"`
+	Results   []nytapi.Geo `json:"results,omitempty"`
+}
+
+func newFetchGeoAPIResponse(res *http.Response) (*fetchGeoAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no FetchGeoAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(fetchGeoAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an FetchGeoResponse failed with error: %v", err)
+	}
+	if response.Results == nil {
+		response.Results = []nytapi.Geo{}
+	}
+
+	return response, nil
+}
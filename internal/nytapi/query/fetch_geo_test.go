@@ -0,0 +1,320 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchGeoHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"meta": {
+					"results": 1
+				},
+				"results": [
+					{
+					"concept_id": 16917,
+					"concept_name": "Brooklyn (NYC)",
+					"geocode_id": 2094,
+					"geoname_id": 5110302,
+					"name": "Brooklyn",
+					"latitude": 40.6501,
+					"longitude": -73.94958,
+					"elevation": 7,
+					"feature_class": "P",
+					"feature_code": "PPLA2",
+					"country_code": "US",
+					"country_name": "United States",
+					"admin_code1": "NY",
+					"admin_name1": "New York",
+					"admin_code2": "047",
+					"admin_name2": "Kings County",
+					"population": 2300664,
+					"time_zone_id": "America/New_York"
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchGeo := query.FetchGeo{
+		Query: "Brooklyn (NYC)",
+		Limit: 1,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchGeoHandler{
+		Query: fetchGeo,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	places, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, places) && assert.Len(t, *places, 1) {
+		place := (*places)[0]
+		assert.Equal(t, "Brooklyn", place.Name)
+		assert.Equal(t, "40.6501", place.Latitude.String())
+		assert.Equal(t, "-73.94958", place.Longitude.String())
+		assert.Equal(t, "P", place.FeatureClass)
+		assert.Equal(t, "United States", place.CountryName)
+		assert.Equal(t, "2300664", place.Population.String())
+	}
+}
+
+func Test_FetchGeoHandler_HandlesSuccessResponseWithNumbersAsStrings_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"results": [
+					{
+					"name": "Brooklyn",
+					"latitude": "40.6501",
+					"longitude": "-73.94958",
+					"elevation": "7",
+					"population": "2300664"
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchGeo := query.FetchGeo{
+		Query: "Brooklyn (NYC)",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchGeoHandler{
+		Query: fetchGeo,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	places, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, places) && assert.Len(t, *places, 1) {
+		place := (*places)[0]
+		assert.Equal(t, 40.6501, place.Latitude.Float64())
+		assert.Equal(t, -73.94958, place.Longitude.Float64())
+		assert.Equal(t, 7.0, place.Elevation.Float64())
+		assert.Equal(t, int64(2300664), place.Population.Int64())
+	}
+}
+
+func Test_FetchGeoHandler_HandlesSuccessResponseWithEmptyNumbers_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"results": [
+					{
+					"name": "Atlantis",
+					"latitude": "",
+					"longitude": "",
+					"elevation": "",
+					"population": null
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchGeo := query.FetchGeo{
+		Query: "Atlantis",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchGeoHandler{
+		Query: fetchGeo,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	places, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, places) && assert.Len(t, *places, 1) {
+		place := (*places)[0]
+		assert.Equal(t, "Atlantis", place.Name)
+		assert.Equal(t, "", place.Latitude.String())
+		assert.Equal(t, 0.0, place.Longitude.Float64())
+		assert.Equal(t, int64(0), place.Population.Int64())
+	}
+}
+
+func Test_FetchGeoHandler_HandlesSuccessResponseWithInvalidNumbers_WithError(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"results": [
+					{
+					"name": "Brooklyn",
+					"latitude": "north"
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchGeo := query.FetchGeo{
+		Query: "Brooklyn (NYC)",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchGeoHandler{
+		Query: fetchGeo,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	places, err := sut.Handle(ctx)
+
+	require.Nil(t, places)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchGeoHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchGeo := query.FetchGeo{
+		Query: "Brooklyn (NYC)",
+		Limit: 1,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchGeoHandler{
+		Query: fetchGeo,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	places, err := sut.Handle(ctx)
+
+	require.Nil(t, places)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchGeoHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchGeo := query.FetchGeo{
+		Query: "Brooklyn (NYC)",
+		Limit: 1,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchGeoHandler{
+		Query: fetchGeo,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	places, err := sut.Handle(ctx)
+
+	require.Nil(t, places)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchGeoHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchGeo := query.FetchGeo{
+		Query: "Brooklyn (NYC)",
+		Limit: 1,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchGeoHandler{
+		Query: fetchGeo,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	places, err := sut.Handle(ctx)
+
+	require.Nil(t, places)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchGeoHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchGeo := query.FetchGeo{
+		Query: "Brooklyn (NYC)",
+		Limit: 1,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchGeoHandler{
+		Query: fetchGeo,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	places, err := sut.Handle(ctx)
+
+	require.Nil(t, places)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
@@ -44,7 +44,7 @@ func (h *FetchMostPopularHandler) Handle(ctx context.Context) (*[]nytapi.Popular
 }
 
 func (h *FetchMostPopularHandler) newFetchMostPopularHTTPRequest(ctx context.Context) (*http.Request, error) {
-	url := fmt.Sprintf("%v/mostpopular/v2/%v/%v.json?api-key=%v", h.Port.BaseURL, h.Query.Category, h.Query.Period, h.Port.APIKey)
+	url := fmt.Sprintf("%v/mostpopular/v2/%v/%v.json", h.Port.BaseURL, h.Query.Category, h.Query.Period)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
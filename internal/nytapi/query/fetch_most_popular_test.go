@@ -96,7 +96,6 @@ func Test_FetchMostPopularHandler_HandlesSuccessResponseWithBody_WithValue(t *te
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchMostPopularHandler{
 		Query: fetchMostPopular,
@@ -126,7 +125,6 @@ func Test_FetchMostPopularHandler_HandlesSuccessResponseWithInvalidBody_WithErro
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchMostPopularHandler{
 		Query: fetchMostPopular,
@@ -152,7 +150,6 @@ func Test_FetchMostPopularHandler_HandlesSuccessResponseWithoutBody_WithError(t
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchMostPopularHandler{
 		Query: fetchMostPopular,
@@ -179,7 +176,6 @@ func Test_FetchMostPopularHandler_HandlesFailureResponse_WithError(t *testing.T)
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchMostPopularHandler{
 		Query: fetchMostPopular,
@@ -212,7 +208,6 @@ func Test_FetchMostPopularHandler_HandlesFailureResponseBody_WithError(t *testin
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchMostPopularHandler{
 		Query: fetchMostPopular,
@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchMostShared models a query for fetching the 'most popular' articles shared via a given share type of the New York Times API.
+type FetchMostShared struct {
+	Period    int
+	ShareType string
+}
+
+// FetchMostSharedHandler is used to handle a FetchMostShared query.
+type FetchMostSharedHandler struct {
+	Query FetchMostShared
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for the most shared articles of a share type for a given time period from the New York Times API.
+func (h *FetchMostSharedHandler) Handle(ctx context.Context) (*[]nytapi.PopularArticle, error) {
+	req, err := h.newFetchMostSharedHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := newFetchMostPopularAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Results, nil
+}
+
+func (h *FetchMostSharedHandler) newFetchMostSharedHTTPRequest(ctx context.Context) (*http.Request, error) {
+	url := fmt.Sprintf("%v/mostpopular/v2/shared/%v/%v.json", h.Port.BaseURL, h.Query.Period, h.Query.ShareType)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchMostSharedRequest with error: %v", err)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,178 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchMostSharedHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 1,
+				"results": [
+					{
+					"uri": "nyt://article/ea6e6f6e-f40a-5be8-99b4-a4ffa79531d3",
+					"url": "https://www.nytimes.com/2021/06/09/well/move/exercise-blood-test.html",
+					"id": 100000007804694,
+					"source": "New York Times",
+					"published_date": "2021-06-09",
+					"section": "Well",
+					"byline": "By Gretchen Reynolds",
+					"type": "Article",
+					"title": "The Best Type of Exercise? A Blood Test Holds Clues"
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchMostShared := query.FetchMostShared{
+		Period:    7,
+		ShareType: "facebook",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchMostSharedHandler{
+		Query: fetchMostShared,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, articles) && assert.Len(t, *articles, 1) {
+		assert.Equal(t, "The Best Type of Exercise? A Blood Test Holds Clues", (*articles)[0].Title)
+		assert.Equal(t, "Well", (*articles)[0].Section)
+	}
+}
+
+func Test_FetchMostSharedHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchMostShared := query.FetchMostShared{
+		Period:    7,
+		ShareType: "facebook",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchMostSharedHandler{
+		Query: fetchMostShared,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchMostSharedHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchMostShared := query.FetchMostShared{
+		Period:    7,
+		ShareType: "facebook",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchMostSharedHandler{
+		Query: fetchMostShared,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchMostSharedHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchMostShared := query.FetchMostShared{
+		Period:    7,
+		ShareType: "facebook",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchMostSharedHandler{
+		Query: fetchMostShared,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchMostSharedHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchMostShared := query.FetchMostShared{
+		Period:    7,
+		ShareType: "facebook",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchMostSharedHandler{
+		Query: fetchMostShared,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
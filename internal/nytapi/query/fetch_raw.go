@@ -0,0 +1,80 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchRaw models a query for fetching an arbitrary endpoint of the New York Times API, given by its path below the base URL.
+type FetchRaw struct {
+	Path   string
+	Params url.Values
+}
+
+// FetchRawHandler is used to handle a FetchRaw query.
+type FetchRawHandler struct {
+	Query FetchRaw
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for an arbitrary endpoint from the New York Times API, leaving the response unparsed.
+func (h *FetchRawHandler) Handle(ctx context.Context) (*nytapi.RawResponse, error) {
+	req, err := h.newFetchRawHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rawResponse, err := newFetchRawAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse, nil
+}
+
+func (h *FetchRawHandler) newFetchRawHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	for key, values := range h.Query.Params {
+		params[key] = append([]string{}, values...)
+	}
+
+	url := fmt.Sprintf("%v/%v?%v", h.Port.BaseURL, strings.TrimPrefix(h.Query.Path, "/"), params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchRawRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+func newFetchRawAPIResponse(res *http.Response) (*nytapi.RawResponse, error) {
+	rawResponse := nytapi.RawResponse{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+	}
+	if res.Body == nil {
+		return &rawResponse, nil
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+	rawResponse.Body = body
+
+	return &rawResponse, nil
+}
@@ -0,0 +1,141 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchRawHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `totally not parsed`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	var requestedURL *url.URL
+	fetchRaw := query.FetchRaw{
+		Path:   "/books/v3/lists/names.json",
+		Params: url.Values{"offset": []string{"20"}, "api-key": []string{"overridden"}},
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL
+			header := http.Header{"Content-Type": []string{"application/json"}}
+			return &http.Response{StatusCode: 200, Header: header, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com/svc",
+		APIKeys:    port.NewKeyPool([]string{"1234567890"}, port.RateLimit{}, 0),
+	}
+	sut := query.FetchRawHandler{
+		Query: fetchRaw,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	rawResponse, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, rawResponse) {
+		assert.Equal(t, 200, rawResponse.StatusCode)
+		assert.Equal(t, "application/json", rawResponse.Header.Get("Content-Type"))
+		assert.Equal(t, json, string(rawResponse.Body))
+	}
+	assert.Equal(t, "/svc/books/v3/lists/names.json", requestedURL.Path)
+	assert.Equal(t, "20", requestedURL.Query().Get("offset"))
+	assert.Equal(t, []string{"1234567890"}, requestedURL.Query()["api-key"])
+}
+
+func Test_FetchRawHandler_HandlesSuccessResponseWithoutBody_WithValue(t *testing.T) {
+	fetchRaw := query.FetchRaw{
+		Path: "books/v3/lists/names.json",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 204}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchRawHandler{
+		Query: fetchRaw,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	rawResponse, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, rawResponse) {
+		assert.Equal(t, 204, rawResponse.StatusCode)
+		assert.Empty(t, rawResponse.Body)
+	}
+}
+
+func Test_FetchRawHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchRaw := query.FetchRaw{
+		Path: "books/v3/lists/names.json",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchRawHandler{
+		Query: fetchRaw,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	rawResponse, err := sut.Handle(ctx)
+
+	require.Nil(t, rawResponse)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchRawHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchRaw := query.FetchRaw{
+		Path: "books/v3/lists/names.json",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchRawHandler{
+		Query: fetchRaw,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	rawResponse, err := sut.Handle(ctx)
+
+	require.Nil(t, rawResponse)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
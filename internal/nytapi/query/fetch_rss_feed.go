@@ -0,0 +1,65 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchRSSFeed models a query for fetching one of the public RSS feeds of the New York Times, e.g. World.
+// The port is expected to point to the feed server, no API key is sent along.
+type FetchRSSFeed struct {
+	Feed string
+}
+
+// FetchRSSFeedHandler is used to handle a FetchRSSFeed query.
+type FetchRSSFeedHandler struct {
+	Query FetchRSSFeed
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for an RSS feed of the New York Times, converting its items into articles.
+func (h *FetchRSSFeedHandler) Handle(ctx context.Context) (*[]nytapi.Article, *time.Time, error) {
+	req, err := h.newFetchRSSFeedHTTPRequest(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	feed, err := newFetchRSSFeedResponse(res)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	articles, lastUpdated := feed.Articles()
+	return articles, lastUpdated, nil
+}
+
+func (h *FetchRSSFeedHandler) newFetchRSSFeedHTTPRequest(ctx context.Context) (*http.Request, error) {
+	url := fmt.Sprintf("%v/%v.xml", h.Port.BaseURL, url.PathEscape(h.Query.Feed))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchRSSFeedRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+func newFetchRSSFeedResponse(res *http.Response) (*nytapi.RSSFeed, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no FetchRSSFeedResponse given")
+	}
+
+	defer res.Body.Close()
+	return nytapi.DecodeRSSFeed(res.Body)
+}
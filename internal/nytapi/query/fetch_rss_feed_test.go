@@ -0,0 +1,204 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchRSSFeedHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `<?xml version="1.0" encoding="UTF-8"?>
+<rss xmlns:atom="http://www.w3.org/2005/Atom" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:media="http://search.yahoo.com/mrss/" xmlns:nyt="http://www.nytimes.com/namespaces/rss/2.0" version="2.0">
+	<channel>
+		<title>NYT &gt; World News</title>
+		<link>https://www.nytimes.com/section/world</link>
+		<description></description>
+		<lastBuildDate>Mon, 05 Jul 2021 14:39:57 +0000</lastBuildDate>
+		<pubDate>Mon, 05 Jul 2021 14:21:18 +0000</pubDate>
+		<item>
+			<title>England Set to Scrap Most Covid Restrictions</title>
+			<link>https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html</link>
+			<guid isPermaLink="true">https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html</guid>
+			<description>Prime Minister Boris Johnson said he would lift most restrictions on July 19.</description>
+			<dc:creator>Megan Specia</dc:creator>
+			<dc:creator>Stephen Castle</dc:creator>
+			<pubDate>Mon, 05 Jul 2021 13:58:39 +0000</pubDate>
+			<category domain="http://www.nytimes.com/namespaces/keywords/des">Coronavirus Reopenings</category>
+			<category domain="http://www.nytimes.com/namespaces/keywords/nyt_per">Johnson, Boris</category>
+			<category domain="http://www.nytimes.com/namespaces/keywords/nyt_geo">England</category>
+			<category domain="http://www.nytimes.com/namespaces/keywords/nyt_org">National Health Service (Britain)</category>
+			<category domain="http://www.nytimes.com/namespaces/keywords/mdes">Quarantine (Life and Culture)</category>
+			<media:content height="151" medium="image" url="https://static01.nyt.com/images/2021/07/05/world/05virus-britain/05virus-britain-moth.jpg" width="151"></media:content>
+			<media:credit>Andrew Testa for The New York Times</media:credit>
+			<media:description>A pub in London in June.</media:description>
+		</item>
+	</channel>
+</rss>`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchRSSFeed := query.FetchRSSFeed{
+		Feed: "World",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchRSSFeedHandler{
+		Query: fetchRSSFeed,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, lastUpdated, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, lastUpdated) {
+		assert.Equal(t, time.Date(2021, 7, 5, 14, 39, 57, 0, time.UTC), lastUpdated.UTC())
+	}
+	if assert.NotNil(t, articles) && assert.Len(t, *articles, 1) {
+		article := (*articles)[0]
+		assert.Equal(t, "England Set to Scrap Most Covid Restrictions", article.Title)
+		assert.Equal(t, "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html", article.Url)
+		assert.Equal(t, "Prime Minister Boris Johnson said he would lift most restrictions on July 19.", article.Abstract)
+		assert.Equal(t, "By Megan Specia and Stephen Castle", article.Byline)
+		assert.Equal(t, time.Date(2021, 7, 5, 13, 58, 39, 0, time.UTC), article.PublishedDate.UTC())
+		assert.Equal(t, []string{"Coronavirus Reopenings", "Quarantine (Life and Culture)"}, article.DesFacet)
+		assert.Equal(t, []string{"Johnson, Boris"}, article.PerFacet)
+		assert.Equal(t, []string{"England"}, article.GeoFacet)
+		assert.Equal(t, []string{"National Health Service (Britain)"}, article.OrgFacet)
+		if assert.Len(t, article.Multimedia, 1) {
+			assert.Equal(t, int32(151), article.Multimedia[0].Width)
+			assert.Equal(t, "image", article.Multimedia[0].Mediatype)
+			assert.Equal(t, "Andrew Testa for The New York Times", article.Multimedia[0].Copyright)
+			assert.Equal(t, "A pub in London in June.", article.Multimedia[0].Caption)
+		}
+	}
+}
+
+func Test_FetchRSSFeedHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchRSSFeed := query.FetchRSSFeed{
+		Feed: "World",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchRSSFeedHandler{
+		Query: fetchRSSFeed,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, lastUpdated, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	require.Nil(t, lastUpdated)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchRSSFeedHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchRSSFeed := query.FetchRSSFeed{
+		Feed: "World",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchRSSFeedHandler{
+		Query: fetchRSSFeed,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, lastUpdated, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	require.Nil(t, lastUpdated)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchRSSFeedHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchRSSFeed := query.FetchRSSFeed{
+		Feed: "World",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchRSSFeedHandler{
+		Query: fetchRSSFeed,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, lastUpdated, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	require.Nil(t, lastUpdated)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchRSSFeedHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchRSSFeed := query.FetchRSSFeed{
+		Feed: "World",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchRSSFeedHandler{
+		Query: fetchRSSFeed,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, lastUpdated, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	require.Nil(t, lastUpdated)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
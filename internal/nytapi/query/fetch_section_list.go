@@ -0,0 +1,79 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchSectionList models a query for fetching the list of sections of the 'Times wire' of the New York Times API.
+type FetchSectionList struct{}
+
+// FetchSectionListHandler is used to handle a FetchSectionList query.
+type FetchSectionListHandler struct {
+	Query FetchSectionList
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for the section list from the New York Times API.
+func (h *FetchSectionListHandler) Handle(ctx context.Context) (*[]nytapi.WireSection, error) {
+	req, err := h.newFetchSectionListHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := newFetchSectionListAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Results, nil
+}
+
+func (h *FetchSectionListHandler) newFetchSectionListHTTPRequest(ctx context.Context) (*http.Request, error) {
+	url := fmt.Sprintf("%v/news/v3/content/section-list.json", h.Port.BaseURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchSectionListRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type fetchSectionListAPIResponse struct {
+	Status     string               `json:"status,omitempty"`
+	Copyright  string               `json:"copyright,omitempty"`
+	NumResults int                  `json:"num_results,omitempty"`
+	Results    []nytapi.WireSection `json:"results,omitempty"`
+}
+
+func newFetchSectionListAPIResponse(res *http.Response) (*fetchSectionListAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no FetchSectionListAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(fetchSectionListAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an FetchSectionListResponse failed with error: %v", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,164 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchSectionListHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 3,
+				"results": [
+					{
+					"section": "arts",
+					"display_name": "Arts"
+					},
+					{
+					"section": "u.s.",
+					"display_name": "U.S."
+					},
+					{
+					"section": "climate",
+					"display_name": "Climate"
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchSectionList := query.FetchSectionList{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchSectionListHandler{
+		Query: fetchSectionList,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	sections, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, sections) && assert.Len(t, *sections, 3) {
+		assert.Equal(t, "u.s.", (*sections)[1].Section)
+		assert.Equal(t, "U.S.", (*sections)[1].DisplayName)
+	}
+}
+
+func Test_FetchSectionListHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchSectionList := query.FetchSectionList{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchSectionListHandler{
+		Query: fetchSectionList,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	sections, err := sut.Handle(ctx)
+
+	require.Nil(t, sections)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchSectionListHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchSectionList := query.FetchSectionList{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchSectionListHandler{
+		Query: fetchSectionList,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	sections, err := sut.Handle(ctx)
+
+	require.Nil(t, sections)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchSectionListHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchSectionList := query.FetchSectionList{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchSectionListHandler{
+		Query: fetchSectionList,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	sections, err := sut.Handle(ctx)
+
+	require.Nil(t, sections)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchSectionListHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchSectionList := query.FetchSectionList{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchSectionListHandler{
+		Query: fetchSectionList,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	sections, err := sut.Handle(ctx)
+
+	require.Nil(t, sections)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
@@ -44,7 +44,7 @@ func (h *FetchTopStoriesHandler) Handle(ctx context.Context) (*[]nytapi.Article,
 }
 
 func (h *FetchTopStoriesHandler) newFetchTopStoriesHTTPRequest(ctx context.Context) (*http.Request, error) {
-	url := fmt.Sprintf("%v/topstories/v2/%v.json?api-key=%v", h.Port.BaseURL, h.Query.Section, h.Port.APIKey)
+	url := fmt.Sprintf("%v/topstories/v2/%v.json", h.Port.BaseURL, h.Query.Section)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -84,7 +84,6 @@ func Test_FetchTopStoriesHandler_HandlesSuccessResponseWithBody_WithValue(t *tes
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchTopStoriesHandler{
 		Query: fetchTopStories,
@@ -114,7 +113,6 @@ func Test_FetchTopStoriesHandler_HandlesSuccessResponseWithInvalidBody_WithError
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchTopStoriesHandler{
 		Query: fetchTopStories,
@@ -140,7 +138,6 @@ func Test_FetchTopStoriesHandler_HandlesSuccessResponseWithoutBody_WithError(t *
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchTopStoriesHandler{
 		Query: fetchTopStories,
@@ -167,7 +164,6 @@ func Test_FetchTopStoriesHandler_HandlesFailureResponse_WithError(t *testing.T)
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchTopStoriesHandler{
 		Query: fetchTopStories,
@@ -200,7 +196,6 @@ func Test_FetchTopStoriesHandler_HandlesFailureResponseBody_WithError(t *testing
 	mockedPort := port.HTTPPort{
 		HTTPClient: &mockedHTTPClient,
 		BaseURL:    "https://test-is-mocked.com",
-		APIKey:     "1234567890",
 	}
 	sut := query.FetchTopStoriesHandler{
 		Query: fetchTopStories,
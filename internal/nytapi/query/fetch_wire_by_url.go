@@ -0,0 +1,56 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchWireByURL models a query for looking up a single article by its URL via the 'Times wire' of the New York Times API.
+type FetchWireByURL struct {
+	URL string
+}
+
+// FetchWireByURLHandler is used to handle a FetchWireByURL query.
+type FetchWireByURLHandler struct {
+	Query FetchWireByURL
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for an article by URL from the New York Times API.
+func (h *FetchWireByURLHandler) Handle(ctx context.Context) (*[]nytapi.WireArticle, error) {
+	req, err := h.newFetchWireByURLHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := newFetchWireContentAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Results, nil
+}
+
+func (h *FetchWireByURLHandler) newFetchWireByURLHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	params.Set("url", h.Query.URL)
+
+	url := fmt.Sprintf("%v/news/v3/content.json?%v", h.Port.BaseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchWireByURLRequest with error: %v", err)
+	}
+
+	return req, nil
+}
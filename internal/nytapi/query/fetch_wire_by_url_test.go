@@ -0,0 +1,177 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchWireByURLHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 1,
+				"results": [
+					{
+					"section": "World",
+					"subsection": "Europe",
+					"title": "England Moves to Lift Its Remaining Virus Restrictions",
+					"url": "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html",
+					"byline": "By Mark Landler",
+					"published_date": "2021-07-05T10:35:08-04:00",
+					"des_facet": ["Coronavirus (2019-nCoV)"],
+					"org_facet": "",
+					"per_facet": ["Johnson, Boris"],
+					"geo_facet": ["England"],
+					"multimedia": ""
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchWireByURL := query.FetchWireByURL{
+		URL: "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchWireByURLHandler{
+		Query: fetchWireByURL,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, articles) && assert.Len(t, *articles, 1) {
+		article := (*articles)[0]
+		assert.Equal(t, "By Mark Landler", article.Byline)
+		assert.Equal(t, nytapi.Facets{"England"}, article.GeoFacet)
+	}
+}
+
+func Test_FetchWireByURLHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchWireByURL := query.FetchWireByURL{
+		URL: "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchWireByURLHandler{
+		Query: fetchWireByURL,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchWireByURLHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchWireByURL := query.FetchWireByURL{
+		URL: "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchWireByURLHandler{
+		Query: fetchWireByURL,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchWireByURLHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchWireByURL := query.FetchWireByURL{
+		URL: "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchWireByURLHandler{
+		Query: fetchWireByURL,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchWireByURLHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchWireByURL := query.FetchWireByURL{
+		URL: "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchWireByURLHandler{
+		Query: fetchWireByURL,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
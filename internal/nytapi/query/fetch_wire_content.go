@@ -0,0 +1,99 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// FetchWireContent models a query for fetching the latest articles of the 'Times wire' of the New York Times API.
+// A time period of 0 omits the window of hours, a limit of 0 leaves the number of results to the API.
+type FetchWireContent struct {
+	Source     string
+	Section    string
+	TimePeriod int
+	Limit      int
+	Offset     int
+}
+
+// FetchWireContentHandler is used to handle a FetchWireContent query.
+type FetchWireContentHandler struct {
+	Query FetchWireContent
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for 'Times wire' content from the New York Times API.
+func (h *FetchWireContentHandler) Handle(ctx context.Context) (*[]nytapi.WireArticle, error) {
+	req, err := h.newFetchWireContentHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := newFetchWireContentAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Results, nil
+}
+
+func (h *FetchWireContentHandler) newFetchWireContentHTTPRequest(ctx context.Context) (*http.Request, error) {
+	path := fmt.Sprintf("%v/%v", url.PathEscape(h.Query.Source), url.PathEscape(h.Query.Section))
+	if h.Query.TimePeriod > 0 {
+		path = fmt.Sprintf("%v/%v", path, h.Query.TimePeriod)
+	}
+
+	params := url.Values{}
+	if h.Query.Limit > 0 {
+		params.Set("limit", strconv.Itoa(h.Query.Limit))
+	}
+	params.Set("offset", strconv.Itoa(h.Query.Offset))
+
+	url := fmt.Sprintf("%v/news/v3/content/%v.json?%v", h.Port.BaseURL, path, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET FetchWireContentRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type fetchWireContentAPIResponse struct {
+	Status     string               `json:"status,omitempty"`
+	Copyright  string               `json:"copyright,omitempty"`
+	NumResults int                  `json:"num_results,omitempty"`
+	Results    []nytapi.WireArticle `json:"results,omitempty"`
+}
+
+func newFetchWireContentAPIResponse(res *http.Response) (*fetchWireContentAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no FetchWireContentAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(fetchWireContentAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an FetchWireContentResponse failed with error: %v", err)
+	}
+
+	return response, nil
+}
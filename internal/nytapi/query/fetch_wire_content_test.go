@@ -0,0 +1,277 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_FetchWireContentHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 2,
+				"results": [
+					{
+					"slug_name": "05virus-world",
+					"section": "World",
+					"subsection": "Europe",
+					"title": "England Moves to Lift Its Remaining Virus Restrictions",
+					"abstract": "Prime Minister Boris Johnson said masks and social distancing would no longer be required.",
+					"uri": "nyt://article/0d5e5c0a-0fbb-5fe3-a6d5-4a3bb2b0c7e1",
+					"url": "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html",
+					"byline": "By Mark Landler",
+					"item_type": "Article",
+					"source": "New York Times",
+					"updated_date": "2021-07-05T14:03:52-04:00",
+					"created_date": "2021-07-05T10:35:08-04:00",
+					"published_date": "2021-07-05T10:35:08-04:00",
+					"first_published_date": "2021-07-05T10:35:08-04:00",
+					"material_type_facet": "News",
+					"kicker": "",
+					"subheadline": "",
+					"des_facet": ["Coronavirus (2019-nCoV)"],
+					"org_facet": "",
+					"per_facet": ["Johnson, Boris"],
+					"geo_facet": ["England"],
+					"related_urls": [
+						{
+						"suggested_link_text": "Live Updates",
+						"url": "https://www.nytimes.com/live/2021/07/05/world/covid-delta-variant-vaccine"
+						}
+					],
+					"multimedia": [
+						{
+						"url": "https://static01.nyt.com/images/2021/07/05/world/05virus-world/05virus-world-thumbStandard.jpg",
+						"format": "Standard Thumbnail",
+						"height": 75,
+						"width": 75,
+						"type": "image",
+						"subtype": "photo",
+						"caption": "Boris Johnson at a news conference in London.",
+						"copyright": "Pool photo by Daniel Leal-Olivas"
+						}
+					],
+					"thumbnail_standard": "https://static01.nyt.com/images/2021/07/05/world/05virus-world/05virus-world-thumbStandard.jpg"
+					},
+					{
+					"slug_name": "05brief-world",
+					"section": "World",
+					"title": "Your Monday Briefing",
+					"url": "https://www.nytimes.com/2021/07/05/briefing/your-monday-briefing.html",
+					"published_date": "2021-07-05T01:00:04-04:00",
+					"des_facet": "",
+					"org_facet": "",
+					"per_facet": "",
+					"geo_facet": "",
+					"related_urls": null,
+					"multimedia": "",
+					"thumbnail_standard": ""
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchWireContent := query.FetchWireContent{
+		Source:  "nyt",
+		Section: "world",
+		Limit:   20,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchWireContentHandler{
+		Query: fetchWireContent,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, articles) && assert.Len(t, *articles, 2) {
+		article := (*articles)[0]
+		assert.Equal(t, "New York Times", article.Source)
+		assert.Equal(t, nytapi.Facets{"Johnson, Boris"}, article.PerFacet)
+		assert.Empty(t, article.OrgFacet)
+		assert.Equal(t, "https://static01.nyt.com/images/2021/07/05/world/05virus-world/05virus-world-thumbStandard.jpg", article.ThumbnailStandard)
+		if assert.Len(t, article.RelatedUrls, 1) {
+			assert.Equal(t, "Live Updates", article.RelatedUrls[0].SuggestedLinkText)
+		}
+		assert.Len(t, article.Multimedia, 1)
+
+		briefing := (*articles)[1]
+		assert.Empty(t, briefing.DesFacet)
+		assert.Empty(t, briefing.Multimedia)
+		assert.Empty(t, briefing.RelatedUrls)
+	}
+}
+
+func Test_FetchWireContentHandler_EncodesPathAndQueryParameters_WithValue(t *testing.T) {
+	var cases = []struct {
+		fetchWireContent query.FetchWireContent
+		expectedURL      string
+	}{
+		{query.FetchWireContent{Source: "all", Section: "all"}, "https://test-is-mocked.com/news/v3/content/all/all.json?offset=0"},
+		{query.FetchWireContent{Source: "nyt", Section: "u.s.", Limit: 50, Offset: 20}, "https://test-is-mocked.com/news/v3/content/nyt/u.s..json?limit=50&offset=20"},
+		{query.FetchWireContent{Source: "inyt", Section: "new york", TimePeriod: 24}, "https://test-is-mocked.com/news/v3/content/inyt/new%20york/24.json?offset=0"},
+	}
+
+	for _, tt := range cases {
+		var requestedURL string
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				requestedURL = req.URL.String()
+				body := ioutil.NopCloser(bytes.NewReader([]byte(`{"status": "OK"}`)))
+				return &http.Response{StatusCode: 200, Body: body}, nil
+			},
+		}
+		mockedPort := port.HTTPPort{
+			HTTPClient: &mockedHTTPClient,
+			BaseURL:    "https://test-is-mocked.com",
+		}
+		sut := query.FetchWireContentHandler{
+			Query: tt.fetchWireContent,
+			Port:  mockedPort,
+		}
+		ctx := context.Background()
+
+		_, err := sut.Handle(ctx)
+
+		require.Nil(t, err)
+		assert.Equal(t, tt.expectedURL, requestedURL)
+	}
+}
+
+func Test_FetchWireContentHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchWireContent := query.FetchWireContent{
+		Source:  "nyt",
+		Section: "world",
+		Limit:   20,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchWireContentHandler{
+		Query: fetchWireContent,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchWireContentHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	fetchWireContent := query.FetchWireContent{
+		Source:  "nyt",
+		Section: "world",
+		Limit:   20,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchWireContentHandler{
+		Query: fetchWireContent,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+}
+
+func Test_FetchWireContentHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	fetchWireContent := query.FetchWireContent{
+		Source:  "nyt",
+		Section: "world",
+		Limit:   20,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchWireContentHandler{
+		Query: fetchWireContent,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_FetchWireContentHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	fetchWireContent := query.FetchWireContent{
+		Source:  "nyt",
+		Section: "world",
+		Limit:   20,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.FetchWireContentHandler{
+		Query: fetchWireContent,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	articles, err := sut.Handle(ctx)
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
@@ -0,0 +1,114 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// SearchArticles models a query for searching articles via the 'Article search' of the New York Times API.
+// Dates are expected in the format YYYYMMDD, empty values are omitted from the request.
+type SearchArticles struct {
+	Query       string
+	FilterQuery string
+	BeginDate   string
+	EndDate     string
+	Sort        string
+	Page        int
+	Fields      []string
+}
+
+// SearchArticlesHandler is used to handle a SearchArticles query.
+type SearchArticlesHandler struct {
+	Query SearchArticles
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for searching articles from the New York Times API.
+func (h *SearchArticlesHandler) Handle(ctx context.Context) (*[]nytapi.SearchDocument, *nytapi.SearchMeta, error) {
+	req, err := h.newSearchArticlesHTTPRequest(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	apiResponse, err := newSearchArticlesAPIResponse(res)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &apiResponse.Response.Docs, &apiResponse.Response.Meta, nil
+}
+
+func (h *SearchArticlesHandler) newSearchArticlesHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	if h.Query.Query != "" {
+		params.Set("q", h.Query.Query)
+	}
+	if h.Query.FilterQuery != "" {
+		params.Set("fq", h.Query.FilterQuery)
+	}
+	if h.Query.BeginDate != "" {
+		params.Set("begin_date", h.Query.BeginDate)
+	}
+	if h.Query.EndDate != "" {
+		params.Set("end_date", h.Query.EndDate)
+	}
+	if h.Query.Sort != "" {
+		params.Set("sort", h.Query.Sort)
+	}
+	if len(h.Query.Fields) > 0 {
+		params.Set("fl", strings.Join(h.Query.Fields, ","))
+	}
+	params.Set("page", strconv.Itoa(h.Query.Page))
+
+	url := fmt.Sprintf("%v/search/v2/articlesearch.json?%v", h.Port.BaseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET SearchArticlesRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type searchArticlesAPIResponse struct {
+	Status    string `json:"status,omitempty"`
+	Copyright string `json:"copyright,omitempty"`
+	Response  struct {
+		Docs []nytapi.SearchDocument `json:"docs,omitempty"`
+		Meta nytapi.SearchMeta       `json:"meta,omitempty"`
+	} `json:"response,omitempty"`
+}
+
+func newSearchArticlesAPIResponse(res *http.Response) (*searchArticlesAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no SearchArticlesAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(searchArticlesAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an SearchArticlesResponse failed with error: %v", err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,281 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_SearchArticlesHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company. All Rights Reserved.",
+				"response": {
+					"docs": [
+						{
+						"abstract": "The rover has been exploring Jezero Crater since February.",
+						"web_url": "https://www.nytimes.com/2021/06/01/science/mars-perseverance-rover.html",
+						"snippet": "The rover has been exploring Jezero Crater since February.",
+						"lead_paragraph": "NASA’s Perseverance rover has begun its first science campaign.",
+						"source": "The New York Times",
+						"multimedia": [
+							{
+							"rank": 0,
+							"subtype": "xlarge",
+							"caption": null,
+							"credit": null,
+							"type": "image",
+							"url": "images/2021/06/01/science/01mars-rover/01mars-rover-articleLarge.jpg",
+							"height": 400,
+							"width": 600,
+							"crop_name": "articleLarge"
+							}
+						],
+						"headline": {
+							"main": "Perseverance Begins Its Science Campaign on Mars",
+							"kicker": null,
+							"content_kicker": null,
+							"print_headline": "Rover Starts Science Work",
+							"name": null,
+							"seo": null,
+							"sub": null
+						},
+						"keywords": [
+							{
+							"name": "subject",
+							"value": "Mars (Planet)",
+							"rank": 1,
+							"major": "N"
+							}
+						],
+						"pub_date": "2021-06-01T15:00:07+0000",
+						"document_type": "article",
+						"news_desk": "Science",
+						"section_name": "Science",
+						"byline": {
+							"original": "By Kenneth Chang",
+							"person": [
+								{
+								"firstname": "Kenneth",
+								"middlename": null,
+								"lastname": "Chang",
+								"qualifier": null,
+								"title": null,
+								"role": "reported",
+								"organization": "",
+								"rank": 1
+								}
+							],
+							"organization": null
+						},
+						"type_of_material": "News",
+						"_id": "nyt://article/00000000-0000-0000-0000-000000000000",
+						"word_count": 1234,
+						"uri": "nyt://article/00000000-0000-0000-0000-000000000000"
+						}
+					],
+					"meta": {
+						"hits": 1,
+						"offset": 0,
+						"time": 27
+					}
+				}
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	searchArticles := query.SearchArticles{
+		Query: "mars",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchArticlesHandler{
+		Query: searchArticles,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	documents, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, documents) && assert.Len(t, *documents, 1) {
+		document := (*documents)[0]
+		assert.Equal(t, "Perseverance Begins Its Science Campaign on Mars", document.Headline.Main)
+		assert.Equal(t, "By Kenneth Chang", document.Byline.Original)
+		assert.Len(t, document.Keywords, 1)
+		assert.Len(t, document.Multimedia, 1)
+	}
+	if assert.NotNil(t, meta) {
+		assert.Equal(t, 1, meta.Hits)
+	}
+}
+
+func Test_SearchArticlesHandler_EncodesQueryParameters_WithValue(t *testing.T) {
+	var requestedURL string
+
+	searchArticles := query.SearchArticles{
+		Query:       "mars rover",
+		FilterQuery: `section_name:("Science")`,
+		BeginDate:   "20210101",
+		EndDate:     "20210630",
+		Sort:        "newest",
+		Page:        3,
+		Fields:      []string{"web_url", "headline"},
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL.String()
+			body := ioutil.NopCloser(bytes.NewReader([]byte(`{"status": "OK"}`)))
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchArticlesHandler{
+		Query: searchArticles,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	_, _, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	assert.Contains(t, requestedURL, "https://test-is-mocked.com/search/v2/articlesearch.json?")
+	assert.Contains(t, requestedURL, "q=mars+rover")
+	assert.Contains(t, requestedURL, "fq=section_name%3A%28%22Science%22%29")
+	assert.Contains(t, requestedURL, "begin_date=20210101")
+	assert.Contains(t, requestedURL, "end_date=20210630")
+	assert.Contains(t, requestedURL, "sort=newest")
+	assert.Contains(t, requestedURL, "page=3")
+	assert.Contains(t, requestedURL, "fl=web_url%2Cheadline")
+}
+
+func Test_SearchArticlesHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	searchArticles := query.SearchArticles{
+		Query: "mars",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchArticlesHandler{
+		Query: searchArticles,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	documents, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, documents)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+}
+
+func Test_SearchArticlesHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	searchArticles := query.SearchArticles{
+		Query: "mars",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchArticlesHandler{
+		Query: searchArticles,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	documents, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, documents)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+}
+
+func Test_SearchArticlesHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	searchArticles := query.SearchArticles{
+		Query: "mars",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchArticlesHandler{
+		Query: searchArticles,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	documents, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, documents)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_SearchArticlesHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	searchArticles := query.SearchArticles{
+		Query: "mars",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchArticlesHandler{
+		Query: searchArticles,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	documents, meta, err := sut.Handle(ctx)
+
+	require.Nil(t, documents)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
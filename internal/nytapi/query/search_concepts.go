@@ -0,0 +1,86 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// SearchConcepts models a query for searching concepts of the 'Semantic' New York Times API.
+type SearchConcepts struct {
+	Query string
+}
+
+// SearchConceptsHandler is used to handle a SearchConcepts query.
+type SearchConceptsHandler struct {
+	Query SearchConcepts
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for searching concepts from the New York Times API.
+func (h *SearchConceptsHandler) Handle(ctx context.Context) (*[]nytapi.Concept, error) {
+	req, err := h.newSearchConceptsHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := newConceptsAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Results, nil
+}
+
+func (h *SearchConceptsHandler) newSearchConceptsHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	params.Set("query", h.Query.Query)
+
+	url := fmt.Sprintf("%v/semantic/v2/concept/search.json?%v", h.Port.BaseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET SearchConceptsRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type conceptsAPIResponse struct {
+	Status     string           `json:"status,omitempty"`
+	Copyright  string           `json:"copyright,omitempty"`
+	NumResults int              `json:"num_results,omitempty"`
+	Results    []nytapi.Concept `json:"results,omitempty"`
+}
+
+// Used for both, searching and fetching concepts, as they share the response format.
+func newConceptsAPIResponse(res *http.Response) (*conceptsAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no ConceptsAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(conceptsAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an ConceptsResponse failed with error: %v", err)
+	}
+
+	return response, nil
+}
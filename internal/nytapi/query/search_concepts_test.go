@@ -0,0 +1,181 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_SearchConceptsHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 2,
+				"results": [
+					{
+					"concept_id": 19288,
+					"concept_name": "Obama, Barack",
+					"concept_status": "Active",
+					"is_times_tag": 1,
+					"concept_type": "nytd_per",
+					"concept_created": "2004-07-28 00:00:00",
+					"concept_updated": "2020-03-31 11:55:23",
+					"vernacular": "Barack Obama"
+					},
+					{
+					"concept_id": 34771,
+					"concept_name": "Obama, Michelle",
+					"concept_status": "Active",
+					"is_times_tag": 1,
+					"concept_type": "nytd_per",
+					"vernacular": "Michelle Obama"
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	searchConcepts := query.SearchConcepts{
+		Query: "obama",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchConceptsHandler{
+		Query: searchConcepts,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	concepts, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, concepts) && assert.Len(t, *concepts, 2) {
+		assert.Equal(t, int64(19288), (*concepts)[0].ConceptID)
+		assert.Equal(t, "nytd_per", (*concepts)[0].ConceptType)
+		assert.Equal(t, "Michelle Obama", (*concepts)[1].Vernacular)
+	}
+}
+
+func Test_SearchConceptsHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	searchConcepts := query.SearchConcepts{
+		Query: "obama",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchConceptsHandler{
+		Query: searchConcepts,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	concepts, err := sut.Handle(ctx)
+
+	require.Nil(t, concepts)
+	assert.NotNil(t, err)
+}
+
+func Test_SearchConceptsHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	searchConcepts := query.SearchConcepts{
+		Query: "obama",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchConceptsHandler{
+		Query: searchConcepts,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	concepts, err := sut.Handle(ctx)
+
+	require.Nil(t, concepts)
+	assert.NotNil(t, err)
+}
+
+func Test_SearchConceptsHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	searchConcepts := query.SearchConcepts{
+		Query: "obama",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchConceptsHandler{
+		Query: searchConcepts,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	concepts, err := sut.Handle(ctx)
+
+	require.Nil(t, concepts)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_SearchConceptsHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	searchConcepts := query.SearchConcepts{
+		Query: "obama",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchConceptsHandler{
+		Query: searchConcepts,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	concepts, err := sut.Handle(ctx)
+
+	require.Nil(t, concepts)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
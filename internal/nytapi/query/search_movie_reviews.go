@@ -0,0 +1,114 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// SearchMovieReviews models a query for searching movie reviews of the New York Times API.
+// Date ranges are expected in the format YYYY-MM-DD:YYYY-MM-DD, empty values are omitted from the request.
+type SearchMovieReviews struct {
+	Query           string
+	Reviewer        string
+	CriticsPick     bool
+	OpeningDate     string
+	PublicationDate string
+	Order           string
+	Offset          int
+}
+
+// SearchMovieReviewsHandler is used to handle a SearchMovieReviews query.
+type SearchMovieReviewsHandler struct {
+	Query SearchMovieReviews
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for searching movie reviews from the New York Times API.
+// Besides the reviews it is returned whether further results are available beyond this page.
+func (h *SearchMovieReviewsHandler) Handle(ctx context.Context) (*[]nytapi.MovieReview, bool, error) {
+	req, err := h.newSearchMovieReviewsHTTPRequest(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+
+	apiResponse, err := newMovieReviewsAPIResponse(res)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &apiResponse.Results, apiResponse.HasMore, nil
+}
+
+func (h *SearchMovieReviewsHandler) newSearchMovieReviewsHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	if h.Query.Query != "" {
+		params.Set("query", h.Query.Query)
+	}
+	if h.Query.Reviewer != "" {
+		params.Set("reviewer", h.Query.Reviewer)
+	}
+	if h.Query.CriticsPick {
+		params.Set("critics-pick", "Y")
+	}
+	if h.Query.OpeningDate != "" {
+		params.Set("opening-date", h.Query.OpeningDate)
+	}
+	if h.Query.PublicationDate != "" {
+		params.Set("publication-date", h.Query.PublicationDate)
+	}
+	if h.Query.Order != "" {
+		params.Set("order", h.Query.Order)
+	}
+	params.Set("offset", strconv.Itoa(h.Query.Offset))
+
+	url := fmt.Sprintf("%v/movies/v2/reviews/search.json?%v", h.Port.BaseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET SearchMovieReviewsRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+type movieReviewsAPIResponse struct {
+	Status     string               `json:"status,omitempty"`
+	Copyright  string               `json:"copyright,omitempty"`
+	HasMore    bool                 `json:"has_more,omitempty"`
+	NumResults int                  `json:"num_results,omitempty"`
+	Results    []nytapi.MovieReview `json:"results,omitempty"`
+}
+
+// Used for both, searching movie reviews and fetching critics' picks, as they share the response format.
+func newMovieReviewsAPIResponse(res *http.Response) (*movieReviewsAPIResponse, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no MovieReviewsAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response = new(movieReviewsAPIResponse)
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an MovieReviewsResponse failed with error: %v", err)
+	}
+
+	return response, nil
+}
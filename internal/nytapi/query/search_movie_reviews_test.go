@@ -0,0 +1,242 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_SearchMovieReviewsHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company. All Rights Reserved.",
+				"has_more": true,
+				"num_results": 1,
+				"results": [
+					{
+					"display_title": "The Big Lebowski",
+					"mpaa_rating": "R",
+					"critics_pick": 1,
+					"byline": "Janet Maslin",
+					"headline": "Film Review; A Bowling Ball's-Eye View of Reality",
+					"summary_short": "The Coen brothers return with a shaggy-dog comedy about a Los Angeles slacker mistaken for a millionaire.",
+					"publication_date": "1998-03-06",
+					"opening_date": "1998-03-06",
+					"date_updated": "2017-11-02 04:17:54",
+					"link": {
+						"type": "article",
+						"url": "https://www.nytimes.com/1998/03/06/movies/film-review-a-bowling-ball-s-eye-view-of-reality.html",
+						"suggested_link_text": "Read the New York Times Review of The Big Lebowski"
+					},
+					"multimedia": {
+						"type": "mediumThreeByTwo210",
+						"src": "https://static01.nyt.com/images/2018/03/06/arts/06lebowski1/06lebowski1-mediumThreeByTwo210.jpg",
+						"height": 140,
+						"width": 210
+					}
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	searchMovieReviews := query.SearchMovieReviews{
+		Query:       "lebowski",
+		CriticsPick: true,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchMovieReviewsHandler{
+		Query: searchMovieReviews,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	reviews, hasMore, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	assert.True(t, hasMore)
+	if assert.NotNil(t, reviews) && assert.Len(t, *reviews, 1) {
+		review := (*reviews)[0]
+		assert.Equal(t, "The Big Lebowski", review.DisplayTitle)
+		assert.Equal(t, 1, review.CriticsPick)
+		assert.Equal(t, "https://www.nytimes.com/1998/03/06/movies/film-review-a-bowling-ball-s-eye-view-of-reality.html", review.Link.URL)
+		if assert.NotNil(t, review.Multimedia) {
+			assert.Equal(t, 210, review.Multimedia.Width)
+		}
+	}
+}
+
+func Test_SearchMovieReviewsHandler_EncodesQueryParameters_WithValue(t *testing.T) {
+	var requestedURL string
+
+	searchMovieReviews := query.SearchMovieReviews{
+		Query:           "big lebowski",
+		Reviewer:        "Janet Maslin",
+		CriticsPick:     true,
+		OpeningDate:     "1998-01-01:1998-12-31",
+		PublicationDate: "1998-03-01:1998-03-31",
+		Order:           "by-opening-date",
+		Offset:          40,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL.String()
+			body := ioutil.NopCloser(bytes.NewReader([]byte(`{"status": "OK"}`)))
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchMovieReviewsHandler{
+		Query: searchMovieReviews,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	_, _, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	assert.Contains(t, requestedURL, "https://test-is-mocked.com/movies/v2/reviews/search.json?")
+	assert.Contains(t, requestedURL, "query=big+lebowski")
+	assert.Contains(t, requestedURL, "reviewer=Janet+Maslin")
+	assert.Contains(t, requestedURL, "critics-pick=Y")
+	assert.Contains(t, requestedURL, "opening-date=1998-01-01%3A1998-12-31")
+	assert.Contains(t, requestedURL, "publication-date=1998-03-01%3A1998-03-31")
+	assert.Contains(t, requestedURL, "order=by-opening-date")
+	assert.Contains(t, requestedURL, "offset=40")
+}
+
+func Test_SearchMovieReviewsHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	searchMovieReviews := query.SearchMovieReviews{
+		Query:       "lebowski",
+		CriticsPick: true,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchMovieReviewsHandler{
+		Query: searchMovieReviews,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	reviews, hasMore, err := sut.Handle(ctx)
+
+	require.Nil(t, reviews)
+	assert.False(t, hasMore)
+	assert.NotNil(t, err)
+}
+
+func Test_SearchMovieReviewsHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	searchMovieReviews := query.SearchMovieReviews{
+		Query:       "lebowski",
+		CriticsPick: true,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchMovieReviewsHandler{
+		Query: searchMovieReviews,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	reviews, hasMore, err := sut.Handle(ctx)
+
+	require.Nil(t, reviews)
+	assert.False(t, hasMore)
+	assert.NotNil(t, err)
+}
+
+func Test_SearchMovieReviewsHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	searchMovieReviews := query.SearchMovieReviews{
+		Query:       "lebowski",
+		CriticsPick: true,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchMovieReviewsHandler{
+		Query: searchMovieReviews,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	reviews, hasMore, err := sut.Handle(ctx)
+
+	require.Nil(t, reviews)
+	assert.False(t, hasMore)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_SearchMovieReviewsHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	searchMovieReviews := query.SearchMovieReviews{
+		Query:       "lebowski",
+		CriticsPick: true,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SearchMovieReviewsHandler{
+		Query: searchMovieReviews,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	reviews, hasMore, err := sut.Handle(ctx)
+
+	require.Nil(t, reviews)
+	assert.False(t, hasMore)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
@@ -0,0 +1,104 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// SuggestTags models a query for suggesting tags of the 'TimesTags' New York Times API.
+// An empty filter suggests tags of any type, a max of 0 leaves the number of suggestions to the API.
+type SuggestTags struct {
+	Query  string
+	Filter string
+	Max    int
+}
+
+// SuggestTagsHandler is used to handle a SuggestTags query.
+type SuggestTagsHandler struct {
+	Query SuggestTags
+	Port  port.HTTPPort
+}
+
+// Handle handles the query for tag suggestions from the New York Times API.
+func (h *SuggestTagsHandler) Handle(ctx context.Context) (*[]nytapi.TimesTag, error) {
+	req, err := h.newSuggestTagsHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.Port.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := newSuggestTagsAPIResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (h *SuggestTagsHandler) newSuggestTagsHTTPRequest(ctx context.Context) (*http.Request, error) {
+	params := url.Values{}
+	params.Set("query", h.Query.Query)
+	if h.Query.Filter != "" {
+		params.Set("filter", h.Query.Filter)
+	}
+	if h.Query.Max > 0 {
+		params.Set("max", strconv.Itoa(h.Query.Max))
+	}
+
+	url := fmt.Sprintf("%v/suggest/v1/timestags.json?%v", h.Port.BaseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for GET SuggestTagsRequest with error: %v", err)
+	}
+
+	return req, nil
+}
+
+// The API answers with a bare array holding the query followed by the list of suggested tags,
+// e.g. ["obama", ["Obama, Barack (Per)", "Obama, Michelle (Per)"]].
+func newSuggestTagsAPIResponse(res *http.Response) (*[]nytapi.TimesTag, error) {
+	if res.Body == nil {
+		return nil, fmt.Errorf("no SuggestTagsAPIResponse given")
+	}
+
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body of response with error: %v", err)
+	}
+
+	var response []json.RawMessage
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the request body json into an SuggestTagsResponse failed with error: %v", err)
+	}
+
+	tags := []nytapi.TimesTag{}
+	if len(response) < 2 {
+		return &tags, nil
+	}
+
+	var suggestions []string
+	err = json.Unmarshal(response[1], &suggestions)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling the suggested tags of an SuggestTagsResponse failed with error: %v", err)
+	}
+	for _, suggestion := range suggestions {
+		tags = append(tags, nytapi.NewTimesTag(suggestion))
+	}
+
+	return &tags, nil
+}
@@ -0,0 +1,198 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/query"
+)
+
+func Test_SuggestTagsHandler_HandlesSuccessResponseWithBody_WithValue(t *testing.T) {
+	json := `["brooklyn", ["Brooklyn (NYC) (Geo)", "Brooklyn Heights (NYC) (Geo)", "Brooklyn Nets"]]`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	suggestTags := query.SuggestTags{
+		Query:  "brooklyn",
+		Filter: "(Geo)",
+		Max:    5,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SuggestTagsHandler{
+		Query: suggestTags,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	tags, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, tags) && assert.Len(t, *tags, 3) {
+		assert.Equal(t, nytapi.TimesTag{Name: "Brooklyn (NYC)", Type: "Geo"}, (*tags)[0])
+		assert.Equal(t, nytapi.TimesTag{Name: "Brooklyn Heights (NYC)", Type: "Geo"}, (*tags)[1])
+		assert.Equal(t, nytapi.TimesTag{Name: "Brooklyn Nets"}, (*tags)[2])
+	}
+}
+
+func Test_SuggestTagsHandler_HandlesSuccessResponseWithoutSuggestions_WithValue(t *testing.T) {
+	json := `["zzzz", []]`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	suggestTags := query.SuggestTags{
+		Query: "zzzz",
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SuggestTagsHandler{
+		Query: suggestTags,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	tags, err := sut.Handle(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, tags) {
+		assert.Empty(t, *tags)
+	}
+}
+
+func Test_SuggestTagsHandler_HandlesSuccessResponseWithInvalidBody_WithError(t *testing.T) {
+	json := `totally not valid`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	suggestTags := query.SuggestTags{
+		Query:  "brooklyn",
+		Filter: "(Geo)",
+		Max:    5,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SuggestTagsHandler{
+		Query: suggestTags,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	tags, err := sut.Handle(ctx)
+
+	require.Nil(t, tags)
+	assert.NotNil(t, err)
+}
+
+func Test_SuggestTagsHandler_HandlesSuccessResponseWithoutBody_WithError(t *testing.T) {
+	suggestTags := query.SuggestTags{
+		Query:  "brooklyn",
+		Filter: "(Geo)",
+		Max:    5,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SuggestTagsHandler{
+		Query: suggestTags,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	tags, err := sut.Handle(ctx)
+
+	require.Nil(t, tags)
+	assert.NotNil(t, err)
+}
+
+func Test_SuggestTagsHandler_HandlesFailureResponse_WithError(t *testing.T) {
+	suggestTags := query.SuggestTags{
+		Query:  "brooklyn",
+		Filter: "(Geo)",
+		Max:    5,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 400}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SuggestTagsHandler{
+		Query: suggestTags,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	tags, err := sut.Handle(ctx)
+
+	require.Nil(t, tags)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
+
+func Test_SuggestTagsHandler_HandlesFailureResponseBody_WithError(t *testing.T) {
+	json := `{
+				"unexpected": "message"
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	suggestTags := query.SuggestTags{
+		Query:  "brooklyn",
+		Filter: "(Geo)",
+		Max:    5,
+	}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404, Body: body}, nil
+		},
+	}
+	mockedPort := port.HTTPPort{
+		HTTPClient: &mockedHTTPClient,
+		BaseURL:    "https://test-is-mocked.com",
+	}
+	sut := query.SuggestTagsHandler{
+		Query: suggestTags,
+		Port:  mockedPort,
+	}
+	ctx := context.Background()
+
+	tags, err := sut.Handle(ctx)
+
+	require.Nil(t, tags)
+	assert.NotNil(t, err)
+	assert.IsType(t, apierror.APIError{}, err)
+}
@@ -0,0 +1,10 @@
+package nytapi
+
+import "net/http"
+
+// RawResponse of a request to an arbitrary endpoint of the New York Times API, left unparsed.
+type RawResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       []byte      `json:"body,omitempty"`
+}
@@ -0,0 +1,172 @@
+package nytapi
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// RSSFeed as published by the New York Times in RSS 2.0 format with Media RSS extensions.
+type RSSFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Channel RSSChannel `xml:"channel"`
+}
+
+// RSSChannel of an RSS feed holding the items of the feed.
+type RSSChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	PubDate       string    `xml:"pubDate"`
+	Items         []RSSItem `xml:"item"`
+}
+
+// RSSItem of an RSS feed, describing a single article.
+type RSSItem struct {
+	Title        string            `xml:"title"`
+	Link         string            `xml:"link"`
+	GUID         string            `xml:"guid"`
+	Descriptions []RSSText         `xml:"description"`
+	Creators     []string          `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	PubDate      string            `xml:"pubDate"`
+	Categories   []RSSCategory     `xml:"category"`
+	MediaContent []RSSMediaContent `xml:"http://search.yahoo.com/mrss/ content"`
+	MediaCredit  string            `xml:"http://search.yahoo.com/mrss/ credit"`
+}
+
+// Namespace of the Media RSS extension.
+const rssMediaNamespace = "http://search.yahoo.com/mrss/"
+
+// RSSText is an element which appears in several namespaces, such as description and media:description.
+type RSSText struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+// RSSCategory of an RSS item, the domain naming the kind of keyword, e.g. http://www.nytimes.com/namespaces/keywords/nyt_per.
+type RSSCategory struct {
+	Domain string `xml:"domain,attr"`
+	Value  string `xml:",chardata"`
+}
+
+// RSSMediaContent of an RSS item as defined by Media RSS.
+type RSSMediaContent struct {
+	URL    string `xml:"url,attr"`
+	Medium string `xml:"medium,attr"`
+	Height int32  `xml:"height,attr"`
+	Width  int32  `xml:"width,attr"`
+}
+
+// DecodeRSSFeed reads an RSS feed from the given reader.
+func DecodeRSSFeed(r io.Reader) (*RSSFeed, error) {
+	var feed RSSFeed
+	if err := xml.NewDecoder(r).Decode(&feed); err != nil {
+		return nil, fmt.Errorf("unmarshaling the RSS feed failed with error: %v", err)
+	}
+	return &feed, nil
+}
+
+// Articles converts the items of the feed into articles, returning them along with the time the feed was last updated.
+func (f *RSSFeed) Articles() (*[]Article, *time.Time) {
+	articles := []Article{}
+	for _, item := range f.Channel.Items {
+		articles = append(articles, item.Article())
+	}
+	lastUpdated := f.Channel.LastUpdated()
+
+	return &articles, &lastUpdated
+}
+
+// LastUpdated returns the time the channel was last built, falling back to its publication date.
+func (c RSSChannel) LastUpdated() time.Time {
+	if updated := parseRSSDate(c.LastBuildDate); !updated.IsZero() {
+		return updated
+	}
+	return parseRSSDate(c.PubDate)
+}
+
+// Article converts an RSS item into an article, so that it can be handled like articles of the API.
+// Categories are sorted into the facets by their domain, media content becomes multimedia.
+func (i RSSItem) Article() Article {
+	var description, caption string
+	for _, text := range i.Descriptions {
+		if text.XMLName.Space == rssMediaNamespace {
+			caption = strings.TrimSpace(text.Value)
+		} else {
+			description = strings.TrimSpace(text.Value)
+		}
+	}
+
+	article := Article{
+		Title:         strings.TrimSpace(i.Title),
+		Abstract:      description,
+		Url:           strings.TrimSpace(i.Link),
+		Uri:           strings.TrimSpace(i.GUID),
+		Byline:        rssByline(i.Creators),
+		ItemType:      "Article",
+		PublishedDate: parseRSSDate(i.PubDate),
+	}
+
+	for _, category := range i.Categories {
+		value := strings.TrimSpace(category.Value)
+		if value == "" {
+			continue
+		}
+
+		switch category.Domain[strings.LastIndex(category.Domain, "/")+1:] {
+		case "nyt_geo":
+			article.GeoFacet = append(article.GeoFacet, value)
+		case "nyt_org":
+			article.OrgFacet = append(article.OrgFacet, value)
+		case "nyt_per":
+			article.PerFacet = append(article.PerFacet, value)
+		default:
+			article.DesFacet = append(article.DesFacet, value)
+		}
+	}
+
+	for _, content := range i.MediaContent {
+		article.Multimedia = append(article.Multimedia, Multimedia{
+			Url:       content.URL,
+			Format:    content.Medium,
+			Height:    content.Height,
+			Width:     content.Width,
+			Mediatype: content.Medium,
+			Caption:   caption,
+			Copyright: strings.TrimSpace(i.MediaCredit),
+		})
+	}
+
+	return article
+}
+
+// Joins the creators of an item into a byline as used by the API, e.g. "By Jane Doe and John Doe".
+func rssByline(creators []string) string {
+	names := []string{}
+	for _, creator := range creators {
+		if creator = strings.TrimSpace(creator); creator != "" {
+			names = append(names, creator)
+		}
+	}
+
+	switch len(names) {
+	case 0:
+		return ""
+	case 1:
+		return "By " + names[0]
+	}
+	return "By " + strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+// Parses the RFC 822 dates of RSS, which appear with numeric as well as named time zones.
+func parseRSSDate(value string) time.Time {
+	for _, layout := range []string{time.RFC1123Z, time.RFC1123, "Mon, 2 Jan 2006 15:04:05 -0700", "Mon, 2 Jan 2006 15:04:05 MST"} {
+		if date, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+			return date
+		}
+	}
+	return time.Time{}
+}
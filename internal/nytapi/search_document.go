@@ -0,0 +1,84 @@
+package nytapi
+
+// SearchDocument as delivered by the New York Times 'Article search' API.
+type SearchDocument struct {
+	ID             string             `json:"_id,omitempty"`
+	URI            string             `json:"uri,omitempty"`
+	WebURL         string             `json:"web_url,omitempty"`
+	Abstract       string             `json:"abstract,omitempty"`
+	Snippet        string             `json:"snippet,omitempty"`
+	LeadParagraph  string             `json:"lead_paragraph,omitempty"`
+	PrintSection   string             `json:"print_section,omitempty"`
+	PrintPage      string             `json:"print_page,omitempty"`
+	Source         string             `json:"source,omitempty"`
+	Multimedia     []SearchMultimedia `json:"multimedia,omitempty"`
+	Headline       SearchHeadline     `json:"headline,omitempty"`
+	Keywords       []SearchKeyword    `json:"keywords,omitempty"`
+	PubDate        string             `json:"pub_date,omitempty"`
+	DocumentType   string             `json:"document_type,omitempty"`
+	NewsDesk       string             `json:"news_desk,omitempty"`
+	SectionName    string             `json:"section_name,omitempty"`
+	SubsectionName string             `json:"subsection_name,omitempty"`
+	Byline         SearchByline       `json:"byline,omitempty"`
+	TypeOfMaterial string             `json:"type_of_material,omitempty"`
+	WordCount      int                `json:"word_count,omitempty"`
+}
+
+// SearchHeadline of a document from the New York Times 'Article search' API.
+type SearchHeadline struct {
+	Main          string `json:"main,omitempty"`
+	Kicker        string `json:"kicker,omitempty"`
+	ContentKicker string `json:"content_kicker,omitempty"`
+	PrintHeadline string `json:"print_headline,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Seo           string `json:"seo,omitempty"`
+	Sub           string `json:"sub,omitempty"`
+}
+
+// SearchKeyword of a document from the New York Times 'Article search' API.
+type SearchKeyword struct {
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+	Rank  int    `json:"rank,omitempty"`
+	Major string `json:"major,omitempty"`
+}
+
+// SearchByline of a document from the New York Times 'Article search' API.
+type SearchByline struct {
+	Original     string         `json:"original,omitempty"`
+	Person       []SearchPerson `json:"person,omitempty"`
+	Organization string         `json:"organization,omitempty"`
+}
+
+// SearchPerson credited in the byline of a document from the New York Times 'Article search' API.
+type SearchPerson struct {
+	Firstname    string `json:"firstname,omitempty"`
+	Middlename   string `json:"middlename,omitempty"`
+	Lastname     string `json:"lastname,omitempty"`
+	Qualifier    string `json:"qualifier,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Role         string `json:"role,omitempty"`
+	Organization string `json:"organization,omitempty"`
+	Rank         int    `json:"rank,omitempty"`
+}
+
+// SearchMultimedia asset belonging to a document from the New York Times 'Article search' API.
+// Please note that the URL is relative to https://www.nytimes.com/.
+type SearchMultimedia struct {
+	Rank     int    `json:"rank,omitempty"`
+	Subtype  string `json:"subtype,omitempty"`
+	Caption  string `json:"caption,omitempty"`
+	Credit   string `json:"credit,omitempty"`
+	Type     string `json:"type,omitempty"`
+	URL      string `json:"url,omitempty"`
+	Height   int    `json:"height,omitempty"`
+	Width    int    `json:"width,omitempty"`
+	CropName string `json:"crop_name,omitempty"`
+}
+
+// SearchMeta describes the paging state of an 'Article search' response.
+type SearchMeta struct {
+	Hits   int `json:"hits"`
+	Offset int `json:"offset"`
+	Time   int `json:"time,omitempty"`
+}
@@ -0,0 +1,20 @@
+package nytapi
+
+import "strings"
+
+// TimesTag as suggested by the New York Times 'TimesTags' API, split into the canonical name and its type.
+type TimesTag struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// NewTimesTag parses a tag as delivered by the API, e.g. "Obama, Barack (Per)".
+// Only the trailing parenthesis holds the type, as names may contain parentheses themselves, e.g. "Brooklyn (NYC) (Geo)".
+func NewTimesTag(tag string) TimesTag {
+	tag = strings.TrimSpace(tag)
+	start := strings.LastIndex(tag, " (")
+	if start < 0 || !strings.HasSuffix(tag, ")") {
+		return TimesTag{Name: tag}
+	}
+	return TimesTag{Name: tag[:start], Type: tag[start+2 : len(tag)-1]}
+}
@@ -0,0 +1,116 @@
+package nytapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// WireArticle as delivered by the New York Times 'Times wire' API.
+type WireArticle struct {
+	SlugName           string         `json:"slug_name,omitempty"`
+	Section            string         `json:"section,omitempty"`
+	Subsection         string         `json:"subsection,omitempty"`
+	Title              string         `json:"title,omitempty"`
+	Abstract           string         `json:"abstract,omitempty"`
+	Url                string         `json:"url,omitempty"`
+	Uri                string         `json:"uri,omitempty"`
+	Byline             string         `json:"byline,omitempty"`
+	ItemType           string         `json:"item_type,omitempty"`
+	Source             string         `json:"source,omitempty"`
+	UpdatedDate        time.Time      `json:"updated_date,omitempty"`
+	CreatedDate        time.Time      `json:"created_date,omitempty"`
+	PublishedDate      time.Time      `json:"published_date,omitempty"`
+	FirstPublishedDate time.Time      `json:"first_published_date,omitempty"`
+	MaterialTypeFacet  string         `json:"material_type_facet,omitempty"`
+	Kicker             string         `json:"kicker,omitempty"`
+	Subheadline        string         `json:"subheadline,omitempty"`
+	DesFacet           Facets         `json:"des_facet,omitempty"`
+	OrgFacet           Facets         `json:"org_facet,omitempty"`
+	PerFacet           Facets         `json:"per_facet,omitempty"`
+	GeoFacet           Facets         `json:"geo_facet,omitempty"`
+	RelatedUrls        []RelatedURL   `json:"related_urls,omitempty"`
+	Multimedia         MultimediaList `json:"multimedia,omitempty"`
+	ThumbnailStandard  string         `json:"thumbnail_standard,omitempty"`
+	ShortUrl           string         `json:"short_url,omitempty"`
+}
+
+// RelatedURL linking an article of the 'Times wire' API to related content.
+type RelatedURL struct {
+	SuggestedLinkText string `json:"suggested_link_text,omitempty"`
+	URL               string `json:"url,omitempty"`
+}
+
+// Facets of an article. The 'Times wire' API delivers an empty string instead of an empty list,
+// which is decoded as no facets.
+type Facets []string
+
+// UnmarshalJSON decodes a list of facets, accepting an empty string for no facets.
+func (f *Facets) UnmarshalJSON(data []byte) error {
+	if isEmptyJSONString(data) {
+		*f = nil
+		return nil
+	}
+
+	var facets []string
+	if err := json.Unmarshal(data, &facets); err != nil {
+		return err
+	}
+	*f = facets
+	return nil
+}
+
+// MultimediaList of an article. The 'Times wire' API delivers an empty string instead of an empty list,
+// which is decoded as no multimedia.
+type MultimediaList []Multimedia
+
+// UnmarshalJSON decodes a list of multimedia assets, accepting an empty string for no assets.
+func (m *MultimediaList) UnmarshalJSON(data []byte) error {
+	if isEmptyJSONString(data) {
+		*m = nil
+		return nil
+	}
+
+	var multimedia []Multimedia
+	if err := json.Unmarshal(data, &multimedia); err != nil {
+		return err
+	}
+	*m = multimedia
+	return nil
+}
+
+func isEmptyJSONString(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte(`""`))
+}
+
+// WireSection as listed by the New York Times 'Times wire' API.
+type WireSection struct {
+	Section     string `json:"section,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+// Article converts a 'Times wire' article into the article model shared by the other APIs,
+// dropping the fields specific to the 'Times wire'.
+func (a WireArticle) Article() Article {
+	return Article{
+		Section:           a.Section,
+		Subsection:        a.Subsection,
+		Title:             a.Title,
+		Abstract:          a.Abstract,
+		Url:               a.Url,
+		Uri:               a.Uri,
+		Byline:            a.Byline,
+		ItemType:          a.ItemType,
+		UpdatedDate:       a.UpdatedDate,
+		CreatedDate:       a.CreatedDate,
+		PublishedDate:     a.PublishedDate,
+		MaterialTypeFacet: a.MaterialTypeFacet,
+		Kicker:            a.Kicker,
+		DesFacet:          a.DesFacet,
+		OrgFacet:          a.OrgFacet,
+		PerFacet:          a.PerFacet,
+		GeoFacet:          a.GeoFacet,
+		Multimedia:        a.Multimedia,
+		ShortUrl:          a.ShortUrl,
+	}
+}
@@ -0,0 +1,25 @@
+package nytapi
+
+import (
+	"fmt"
+	"time"
+)
+
+// ArchiveDocument as delivered by the New York Times 'Archive' API, sharing its model with the 'Article search'.
+type ArchiveDocument = SearchDocument
+
+// First month available in the 'Archive' of the New York Times API.
+var archiveFirstMonth = time.Date(1851, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+// isValidArchiveMonth checks whether a month is available in the 'Archive' of the New York Times API.
+func isValidArchiveMonth(year int, month time.Month) error {
+	if month < time.January || month > time.December {
+		return fmt.Errorf("invalid archive month: %v", int(month))
+	}
+
+	requested := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	if requested.Before(archiveFirstMonth) || requested.After(time.Now().UTC()) {
+		return fmt.Errorf("archive month not available: %04d-%02d", year, int(month))
+	}
+	return nil
+}
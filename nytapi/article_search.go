@@ -0,0 +1,65 @@
+package nytapi
+
+import (
+	"fmt"
+	"time"
+)
+
+// ArticleSearchSort defined by the New York Times API as either: newest, oldest, relevance
+type ArticleSearchSort string
+
+// Valid 'Article search' sort order as defined by the New York Times API.
+const (
+	Newest    ArticleSearchSort = "newest"
+	Oldest    ArticleSearchSort = "oldest"
+	Relevance ArticleSearchSort = "relevance"
+)
+
+// IsValid checks the validity of an 'Article search' sort order.
+func (sort ArticleSearchSort) IsValid() error {
+	switch sort {
+	case Newest, Oldest, Relevance:
+		return nil
+	}
+	return fmt.Errorf("invalid article search sort order: %v", sort)
+}
+
+// ArticleSearchMaxPage is the last page the 'Article search' API will deliver for any query.
+const ArticleSearchMaxPage = 100
+
+// ArticleSearchQuery holds the parameters of an 'Article search'.
+// Zero values are omitted from the request, except for the page which starts at 0.
+type ArticleSearchQuery struct {
+	Query       string
+	FilterQuery string
+	BeginDate   time.Time
+	EndDate     time.Time
+	Sort        ArticleSearchSort
+	Page        int
+	Fields      []string
+}
+
+// IsValid checks the validity of an 'Article search' query.
+func (q ArticleSearchQuery) IsValid() error {
+	if q.Sort != "" {
+		if err := q.Sort.IsValid(); err != nil {
+			return err
+		}
+	}
+	if q.Page < 0 || q.Page > ArticleSearchMaxPage {
+		return fmt.Errorf("invalid article search page: %v", q.Page)
+	}
+	if !q.BeginDate.IsZero() && !q.EndDate.IsZero() && q.EndDate.Before(q.BeginDate) {
+		return fmt.Errorf("invalid article search date range: %v - %v", q.BeginDate.Format(articleSearchDateFormat), q.EndDate.Format(articleSearchDateFormat))
+	}
+	return nil
+}
+
+const articleSearchDateFormat = "20060102"
+
+func formatArticleSearchDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return date.Format(articleSearchDateFormat)
+}
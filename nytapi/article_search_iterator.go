@@ -0,0 +1,212 @@
+package nytapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thorstenpfister/gonyt/nytapi/fq"
+)
+
+// ArticleSearchPageSize is the number of documents the 'Article search' API delivers per page.
+const ArticleSearchPageSize = 10
+
+// Number of hits which can reliably be paged through before reaching ArticleSearchMaxPage,
+// windows with more hits get split into smaller date windows.
+const articleSearchMaxHits = 1000
+
+// Earliest publication date of the New York Times, used as lower bound when splitting an open date window.
+var articleSearchFirstDay = time.Date(1851, time.September, 18, 0, 0, 0, 0, time.UTC)
+
+// ArticleSearchIterator walks all documents matching an 'Article search' lazily, page by page.
+//
+// Whenever a date window holds more hits than the API is able to page through, the window is split
+// recursively, so that every matching document is returned exactly once. Typical usage:
+//
+//	it := client.IterateArticles(ctx, searchQuery)
+//	for it.Next() {
+//		fmt.Println(it.Document().Headline.Main)
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type ArticleSearchIterator struct {
+	ctx     context.Context
+	client  *Client
+	query   ArticleSearchQuery
+	windows []articleSearchWindow
+	page    int
+	buffer  []SearchDocument
+	current SearchDocument
+	seen    map[string]bool
+	err     error
+}
+
+// IterateArticles provides an iterator over all documents of an 'Article search'.
+// The page of the given query is ignored, iteration always starts with the first page.
+func (c *Client) IterateArticles(ctx context.Context, searchQuery ArticleSearchQuery) *ArticleSearchIterator {
+	it := &ArticleSearchIterator{
+		ctx:     ctx,
+		client:  c,
+		query:   searchQuery,
+		windows: []articleSearchWindow{{begin: searchQuery.BeginDate, end: searchQuery.EndDate}},
+		seen:    map[string]bool{},
+	}
+	it.query.Page = 0
+	if err := it.query.IsValid(); err != nil {
+		it.err = err
+	}
+	return it
+}
+
+// Next advances the iterator to the next document, fetching further pages as needed.
+// It returns false once all documents have been visited or an error occurred.
+func (it *ArticleSearchIterator) Next() bool {
+	for {
+		if len(it.buffer) > 0 {
+			document := it.buffer[0]
+			it.buffer = it.buffer[1:]
+			if document.ID != "" {
+				if it.seen[document.ID] {
+					continue
+				}
+				it.seen[document.ID] = true
+			}
+			it.current = document
+			return true
+		}
+
+		if it.err != nil || len(it.windows) == 0 {
+			return false
+		}
+
+		if err := it.fetchPage(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+}
+
+// Document returns the document the iterator currently points to.
+func (it *ArticleSearchIterator) Document() SearchDocument {
+	return it.current
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *ArticleSearchIterator) Err() error {
+	return it.err
+}
+
+func (it *ArticleSearchIterator) fetchPage() error {
+	if err := it.ctx.Err(); err != nil {
+		return err
+	}
+
+	window := it.windows[len(it.windows)-1]
+	searchQuery, err := window.apply(it.query)
+	if err != nil {
+		return err
+	}
+	searchQuery.Page = it.page
+
+	documents, meta, err := it.client.SearchArticles(it.ctx, searchQuery)
+	if err != nil {
+		return err
+	}
+
+	if it.page == 0 && meta.Hits > articleSearchMaxHits {
+		earlier, later, ok := window.split()
+		if !ok {
+			return fmt.Errorf("article search window %v holds %v hits and cannot be split any further", window, meta.Hits)
+		}
+
+		it.windows = it.windows[:len(it.windows)-1]
+		if it.query.Sort == Newest {
+			it.windows = append(it.windows, earlier, later)
+		} else {
+			it.windows = append(it.windows, later, earlier)
+		}
+		return nil
+	}
+
+	it.buffer = *documents
+	if len(*documents) == 0 || (it.page+1)*ArticleSearchPageSize >= meta.Hits || it.page >= ArticleSearchMaxPage {
+		it.windows = it.windows[:len(it.windows)-1]
+		it.page = 0
+	} else {
+		it.page++
+	}
+	return nil
+}
+
+// articleSearchWindow narrows an 'Article search' down to the days between begin and end.
+// Once a single day needs to be split, from and to narrow it down further via a pub_date filter query.
+type articleSearchWindow struct {
+	begin time.Time
+	end   time.Time
+	from  time.Time
+	to    time.Time
+}
+
+func (w articleSearchWindow) String() string {
+	if !w.from.IsZero() {
+		return fmt.Sprintf("%v - %v", w.from.Format(time.RFC3339), w.to.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("%v - %v", formatArticleSearchDate(w.begin), formatArticleSearchDate(w.end))
+}
+
+func (w articleSearchWindow) apply(searchQuery ArticleSearchQuery) (ArticleSearchQuery, error) {
+	searchQuery.BeginDate = w.begin
+	searchQuery.EndDate = w.end
+	if w.from.IsZero() {
+		return searchQuery, nil
+	}
+
+	pubDate, err := fq.Build(fq.DateRange(fq.PubDate, w.from, w.to))
+	if err != nil {
+		return searchQuery, err
+	}
+	if searchQuery.FilterQuery != "" {
+		searchQuery.FilterQuery = fmt.Sprintf("(%v) AND %v", searchQuery.FilterQuery, pubDate)
+	} else {
+		searchQuery.FilterQuery = pubDate
+	}
+	return searchQuery, nil
+}
+
+// Splits a window into two disjoint halves, first by days and then, for a single day, by seconds.
+func (w articleSearchWindow) split() (articleSearchWindow, articleSearchWindow, bool) {
+	if w.begin.IsZero() {
+		w.begin = articleSearchFirstDay
+	}
+	if w.end.IsZero() {
+		w.end = time.Now()
+	}
+	w.begin = articleSearchDay(w.begin)
+	w.end = articleSearchDay(w.end)
+
+	if days := int(w.end.Sub(w.begin).Hours() / 24); days >= 1 {
+		middle := w.begin.AddDate(0, 0, (days-1)/2)
+		return articleSearchWindow{begin: w.begin, end: middle}, articleSearchWindow{begin: middle.AddDate(0, 0, 1), end: w.end}, true
+	}
+
+	if w.from.IsZero() {
+		// The API assigns days in its own time zone, so the pub_date range generously covers
+		// the surrounding days and relies on begin and end to cut off the rest.
+		w.from = w.begin.Add(-24 * time.Hour)
+		w.to = w.begin.Add(48*time.Hour - time.Second)
+	}
+	if !w.to.After(w.from) {
+		return w, w, false
+	}
+
+	middle := w.from.Add(w.to.Sub(w.from) / 2).Truncate(time.Second)
+	earlier := articleSearchWindow{begin: w.begin, end: w.end, from: w.from, to: middle}
+	later := articleSearchWindow{begin: w.begin, end: w.end, from: middle.Add(time.Second), to: w.to}
+	return earlier, later, true
+}
+
+// Reduces a point in time to its calendar day, as the API only accepts whole days for begin and end.
+func articleSearchDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,195 @@
+package nytapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+type mockedSearchDocument struct {
+	ID      string
+	PubDate time.Time
+}
+
+var mockedPubDateFilter = regexp.MustCompile(`pub_date:\[(\S+) TO (\S+)\]`)
+
+// Provides an HTTP client mimicking the 'Article search' API on top of the given documents,
+// including begin and end dates, pub_date filter queries and paging.
+func newMockedArticleSearchHTTPClient(documents []mockedSearchDocument, requests *int) *port.MockedHTTPClient {
+	sort.Slice(documents, func(i, j int) bool { return documents[i].PubDate.Before(documents[j].PubDate) })
+
+	return &port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			*requests++
+			params := req.URL.Query()
+
+			var matches []mockedSearchDocument
+			for _, document := range documents {
+				day := document.PubDate.Format("20060102")
+				if begin := params.Get("begin_date"); begin != "" && day < begin {
+					continue
+				}
+				if end := params.Get("end_date"); end != "" && day > end {
+					continue
+				}
+				if filter := mockedPubDateFilter.FindStringSubmatch(params.Get("fq")); filter != nil {
+					from, _ := time.Parse(time.RFC3339, filter[1])
+					to, _ := time.Parse(time.RFC3339, filter[2])
+					if document.PubDate.Before(from) || document.PubDate.After(to) {
+						continue
+					}
+				}
+				matches = append(matches, document)
+			}
+
+			page, _ := strconv.Atoi(params.Get("page"))
+			if page > nytapi.ArticleSearchMaxPage {
+				return &http.Response{StatusCode: 400}, nil
+			}
+			docs := []map[string]string{}
+			for i := page * nytapi.ArticleSearchPageSize; i < len(matches) && i < (page+1)*nytapi.ArticleSearchPageSize; i++ {
+				docs = append(docs, map[string]string{
+					"_id":      matches[i].ID,
+					"pub_date": matches[i].PubDate.Format("2006-01-02T15:04:05-0700"),
+				})
+			}
+
+			body, _ := json.Marshal(map[string]interface{}{
+				"status": "OK",
+				"response": map[string]interface{}{
+					"docs": docs,
+					"meta": map[string]int{"hits": len(matches), "offset": page * nytapi.ArticleSearchPageSize},
+				},
+			})
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(body))}, nil
+		},
+	}
+}
+
+func newMockedSearchDocuments(start time.Time, count int, spacing time.Duration) []mockedSearchDocument {
+	documents := make([]mockedSearchDocument, count)
+	for i := range documents {
+		documents[i] = mockedSearchDocument{
+			ID:      fmt.Sprintf("nyt://article/%d", i),
+			PubDate: start.Add(time.Duration(i) * spacing),
+		}
+	}
+	return documents
+}
+
+func collectArticleSearchIDs(t *testing.T, it *nytapi.ArticleSearchIterator) map[string]int {
+	ids := map[string]int{}
+	for it.Next() {
+		ids[it.Document().ID]++
+	}
+	require.Nil(t, it.Err())
+	return ids
+}
+
+func Test_ArticleSearchIterator_ShouldWalkAllPages_WithValues(t *testing.T) {
+	requests := 0
+	documents := newMockedSearchDocuments(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 25, time.Hour)
+	sut := nytapi.NewClient(newMockedArticleSearchHTTPClient(documents, &requests), "mockedApiKey")
+
+	ctx := context.Background()
+	ids := collectArticleSearchIDs(t, sut.IterateArticles(ctx, nytapi.ArticleSearchQuery{Query: "mars"}))
+
+	assert.Len(t, ids, 25)
+	assert.Equal(t, 3, requests)
+}
+
+func Test_ArticleSearchIterator_ShouldSplitDateWindows_WithValues(t *testing.T) {
+	var cases = []struct {
+		searchQuery nytapi.ArticleSearchQuery
+	}{
+		{nytapi.ArticleSearchQuery{BeginDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2021, 1, 30, 0, 0, 0, 0, time.UTC)}},
+		{nytapi.ArticleSearchQuery{BeginDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2021, 1, 30, 0, 0, 0, 0, time.UTC), Sort: nytapi.Newest}},
+		{nytapi.ArticleSearchQuery{}},
+	}
+
+	for _, tt := range cases {
+		requests := 0
+		documents := newMockedSearchDocuments(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 1200, 30*time.Minute)
+		sut := nytapi.NewClient(newMockedArticleSearchHTTPClient(documents, &requests), "mockedApiKey")
+
+		ctx := context.Background()
+		ids := collectArticleSearchIDs(t, sut.IterateArticles(ctx, tt.searchQuery))
+
+		assert.Len(t, ids, 1200)
+		for id, count := range ids {
+			assert.Equal(t, 1, count, id)
+		}
+	}
+}
+
+func Test_ArticleSearchIterator_ShouldSplitSingleDay_WithValues(t *testing.T) {
+	requests := 0
+	documents := newMockedSearchDocuments(time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC), 1300, time.Minute)
+	sut := nytapi.NewClient(newMockedArticleSearchHTTPClient(documents, &requests), "mockedApiKey")
+
+	ctx := context.Background()
+	day := time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)
+	ids := collectArticleSearchIDs(t, sut.IterateArticles(ctx, nytapi.ArticleSearchQuery{BeginDate: day, EndDate: day}))
+
+	assert.Len(t, ids, 1300)
+}
+
+func Test_ArticleSearchIterator_ShouldHonourCancellation_WithError(t *testing.T) {
+	requests := 0
+	documents := newMockedSearchDocuments(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 25, time.Hour)
+	sut := nytapi.NewClient(newMockedArticleSearchHTTPClient(documents, &requests), "mockedApiKey")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := sut.IterateArticles(ctx, nytapi.ArticleSearchQuery{Query: "mars"})
+
+	require.True(t, it.Next())
+	cancel()
+	visited := 1
+	for it.Next() {
+		visited++
+	}
+
+	assert.Equal(t, nytapi.ArticleSearchPageSize, visited)
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, context.Canceled, it.Err())
+}
+
+func Test_ArticleSearchIterator_ShouldHandleInvalidQuery_WithError(t *testing.T) {
+	requests := 0
+	sut := nytapi.NewClient(newMockedArticleSearchHTTPClient(nil, &requests), "mockedApiKey")
+
+	ctx := context.Background()
+	it := sut.IterateArticles(ctx, nytapi.ArticleSearchQuery{Sort: "not-a-valid-sort"})
+
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+	assert.Equal(t, 0, requests)
+}
+
+func Test_ArticleSearchIterator_ShouldHandleFailureResponse_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 429}, nil
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "mockedApiKey")
+
+	ctx := context.Background()
+	it := sut.IterateArticles(ctx, nytapi.ArticleSearchQuery{Query: "mars"})
+
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
@@ -0,0 +1,82 @@
+package nytapi_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+func Test_ArticleSearchSort_ShouldBeReflectingSort_WithValue(t *testing.T) {
+	var cases = []struct {
+		sort                      nytapi.ArticleSearchSort
+		expectedArticleSearchSort nytapi.ArticleSearchSort
+	}{
+		{nytapi.ArticleSearchSort("newest"), nytapi.Newest},
+		{nytapi.ArticleSearchSort("oldest"), nytapi.Oldest},
+		{nytapi.ArticleSearchSort("relevance"), nytapi.Relevance},
+	}
+
+	for _, tt := range cases {
+		assert.Equal(t, tt.sort, tt.expectedArticleSearchSort)
+	}
+}
+
+func Test_ArticleSearchSort_ShouldBeValid(t *testing.T) {
+	var cases = []struct {
+		sort nytapi.ArticleSearchSort
+	}{
+		{nytapi.Newest},
+		{nytapi.Oldest},
+		{nytapi.Relevance},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.sort.IsValid())
+	}
+}
+
+func Test_ArticleSearchSort_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		sort nytapi.ArticleSearchSort
+	}{
+		{nytapi.ArticleSearchSort("not-valid")},
+		{"also-not-valid"},
+		{"Newest"},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.sort.IsValid())
+	}
+}
+
+func Test_ArticleSearchQuery_ShouldBeValid(t *testing.T) {
+	var cases = []struct {
+		searchQuery nytapi.ArticleSearchQuery
+	}{
+		{nytapi.ArticleSearchQuery{}},
+		{nytapi.ArticleSearchQuery{Query: "mars", Sort: nytapi.Newest}},
+		{nytapi.ArticleSearchQuery{Page: nytapi.ArticleSearchMaxPage}},
+		{nytapi.ArticleSearchQuery{BeginDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.searchQuery.IsValid())
+	}
+}
+
+func Test_ArticleSearchQuery_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		searchQuery nytapi.ArticleSearchQuery
+	}{
+		{nytapi.ArticleSearchQuery{Sort: "not-valid"}},
+		{nytapi.ArticleSearchQuery{Page: -1}},
+		{nytapi.ArticleSearchQuery{Page: nytapi.ArticleSearchMaxPage + 1}},
+		{nytapi.ArticleSearchQuery{BeginDate: time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.searchQuery.IsValid())
+	}
+}
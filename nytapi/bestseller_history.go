@@ -0,0 +1,25 @@
+package nytapi
+
+import "fmt"
+
+// BestsellerHistoryPageSize is the number of books the bestseller history of the New York Times API delivers per page.
+const BestsellerHistoryPageSize = 20
+
+// BestsellerHistoryQuery holds the filters for looking up the bestseller history of books.
+// Empty filters are omitted from the request, the offset has to be a multiple of BestsellerHistoryPageSize.
+type BestsellerHistoryQuery struct {
+	Author    string
+	Title     string
+	Isbn      string
+	Publisher string
+	AgeGroup  string
+	Offset    int
+}
+
+// IsValid checks the validity of a bestseller history query.
+func (q BestsellerHistoryQuery) IsValid() error {
+	if q.Offset < 0 || q.Offset%BestsellerHistoryPageSize != 0 {
+		return fmt.Errorf("invalid bestseller history offset: %v", q.Offset)
+	}
+	return nil
+}
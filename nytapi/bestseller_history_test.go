@@ -0,0 +1,37 @@
+package nytapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+func Test_BestsellerHistoryQuery_ShouldBeValid(t *testing.T) {
+	var cases = []struct {
+		historyQuery nytapi.BestsellerHistoryQuery
+	}{
+		{nytapi.BestsellerHistoryQuery{}},
+		{nytapi.BestsellerHistoryQuery{Author: "Delia Owens"}},
+		{nytapi.BestsellerHistoryQuery{Title: "Becoming", Offset: 20}},
+		{nytapi.BestsellerHistoryQuery{Publisher: "Putnam", Offset: 200}},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.historyQuery.IsValid())
+	}
+}
+
+func Test_BestsellerHistoryQuery_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		historyQuery nytapi.BestsellerHistoryQuery
+	}{
+		{nytapi.BestsellerHistoryQuery{Offset: -20}},
+		{nytapi.BestsellerHistoryQuery{Offset: 10}},
+		{nytapi.BestsellerHistoryQuery{Author: "Delia Owens", Offset: 21}},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.historyQuery.IsValid())
+	}
+}
@@ -0,0 +1,67 @@
+package nytapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Date format used by the bestseller lists of the New York Times API.
+const bestsellerDateFormat = "2006-01-02"
+
+// The latest published bestseller list is requested via this date.
+const bestsellerCurrentDate = "current"
+
+// bestsellerListNamesCache holds the bestseller list names once fetched, as they are needed to validate
+// every bestseller list request but change rarely.
+type bestsellerListNamesCache struct {
+	mutex     sync.Mutex
+	listNames *[]BestsellerListName
+}
+
+// get returns the cached bestseller list names, fetching them on first use.
+func (cache *bestsellerListNamesCache) get(ctx context.Context, fetch func(context.Context) (*[]BestsellerListName, error)) (*[]BestsellerListName, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if cache.listNames != nil {
+		return cache.listNames, nil
+	}
+
+	listNames, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cache.listNames = listNames
+	return listNames, nil
+}
+
+// isValidBestsellerList checks a requested bestseller list and date against the published list names.
+func isValidBestsellerList(listNames *[]BestsellerListName, listName string, date time.Time) error {
+	for _, candidate := range *listNames {
+		if candidate.ListNameEncoded != listName {
+			continue
+		}
+		if date.IsZero() {
+			return nil
+		}
+
+		requested := date.Format(bestsellerDateFormat)
+		if candidate.OldestPublishedDate != "" && requested < candidate.OldestPublishedDate {
+			return fmt.Errorf("bestseller list %v is not available before %v", listName, candidate.OldestPublishedDate)
+		}
+		if candidate.NewestPublishedDate != "" && requested > candidate.NewestPublishedDate {
+			return fmt.Errorf("bestseller list %v is not available after %v", listName, candidate.NewestPublishedDate)
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid bestseller list name: %v", listName)
+}
+
+func formatBestsellerDate(date time.Time) string {
+	if date.IsZero() {
+		return bestsellerCurrentDate
+	}
+	return date.Format(bestsellerDateFormat)
+}
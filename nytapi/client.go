@@ -2,6 +2,9 @@ package nytapi
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/thorstenpfister/gonyt/internal/nytapi"
@@ -18,26 +21,181 @@ type BookReview = nytapi.BookReview
 // PopularArticle as delivered by the New York Times API.
 type PopularArticle = nytapi.PopularArticle
 
+// SearchDocument as delivered by the New York Times 'Article search' API.
+type SearchDocument = nytapi.SearchDocument
+
+// SearchMeta describes the paging state of an 'Article search' response.
+type SearchMeta = nytapi.SearchMeta
+
+// BestsellerList as delivered by the New York Times API.
+type BestsellerList = nytapi.BestsellerList
+
+// Bestseller is a book ranked on a bestseller list of the New York Times API.
+type Bestseller = nytapi.Bestseller
+
+// BestsellerListName describes a bestseller list as delivered by the New York Times API.
+type BestsellerListName = nytapi.BestsellerListName
+
+// BestsellerOverview of all bestseller lists of a given date as delivered by the New York Times API.
+type BestsellerOverview = nytapi.BestsellerOverview
+
+// BestsellerHistory of a book across all bestseller lists as delivered by the New York Times API.
+type BestsellerHistory = nytapi.BestsellerHistory
+
+// MovieReview as delivered by the New York Times 'Movie reviews' API.
+type MovieReview = nytapi.MovieReview
+
+// Critic reviewing movies for the New York Times as delivered by the New York Times API.
+type Critic = nytapi.Critic
+
+// WireArticle as delivered by the New York Times 'Times wire' API.
+type WireArticle = nytapi.WireArticle
+
+// WireSection as listed by the New York Times 'Times wire' API.
+type WireSection = nytapi.WireSection
+
+// Concept as delivered by the New York Times 'Semantic' API.
+type Concept = nytapi.Concept
+
+// Comment of a reader on an article as delivered by the New York Times 'Community' API.
+type Comment = nytapi.Comment
+
+// CommentsMeta describes the totals of a 'Community' API response.
+type CommentsMeta = nytapi.CommentsMeta
+
+// Geo is a geographic place as delivered by the New York Times 'Geographic' API.
+type Geo = nytapi.Geo
+
+// KeyUsage reports the usage of an API key of the client, see KeyUsage.
+type KeyUsage = port.KeyUsage
+
+// RateLimit configures the client side rate limiting of requests to the API, see WithRateLimit.
+type RateLimit = port.RateLimit
+
+// RateLimitError is returned in place of a request exceeding the configured rate limit or daily budget.
+type RateLimitError = port.RateLimitError
+
+// RawResponse of a request to an arbitrary endpoint of the New York Times API, left unparsed.
+type RawResponse = nytapi.RawResponse
+
+// RequestHook is called with every request right before its execution, e.g. to add headers or to log it.
+// Returning an error aborts the request.
+type RequestHook = port.RequestHook
+
+// RetryPolicy decides whether and when failed requests are retried, see WithRetryPolicy.
+type RetryPolicy = port.RetryPolicy
+
+// TimesTag as suggested by the New York Times 'TimesTags' API.
+type TimesTag = nytapi.TimesTag
+
 // Client for querying the New York Times API.
 type Client struct {
-	port port.HTTPPort
+	port                port.HTTPPort
+	bestsellerListNames *bestsellerListNamesCache
+	sectionList         *sectionListCache
+	liveSections        bool
+	geoFacets           *geoFacetCache
+	rssBaseURL          string
+	apiKeys             []string
+	rateLimit           RateLimit
+	keyCooldown         time.Duration
 }
 
 // NewClient provides a client for querying the New York Times API, providing your own HTTP client and API key.
-func NewClient(httpClient port.HTTPClient, apiKey string) Client {
+// Options are applied in order and allow to deviate from the defaults, e.g. to use a proxy via WithBaseURL
+// or to spread requests over several API keys via WithAPIKeys.
+func NewClient(httpClient port.HTTPClient, apiKey string, options ...Option) Client {
 	client := Client{
 		port: port.HTTPPort{
 			HTTPClient: httpClient,
-			BaseURL:    "https://api.nytimes.com/svc",
-			APIKey:     apiKey,
+			BaseURL:    DefaultBaseURL,
 		},
+		bestsellerListNames: &bestsellerListNamesCache{},
+		sectionList:         &sectionListCache{},
+		geoFacets:           &geoFacetCache{},
+		rssBaseURL:          RSSDefaultBaseURL,
+		apiKeys:             []string{apiKey},
+	}
+	for _, option := range options {
+		option(&client)
 	}
+	client.port.APIKeys = port.NewKeyPool(client.apiKeys, client.rateLimit, client.keyCooldown)
 	return client
 }
 
+// UseLiveSections switches the validation of 'Top stories' sections from the sections known to this library
+// to a cached copy of the section list published by the API. Sections of the section list are accepted in their
+// spelling of the list, e.g. "u.s." for "us", as long as they name a known section. Whenever the section list
+// cannot be fetched only the known sections are accepted.
+func (c *Client) UseLiveSections(enabled bool) {
+	c.liveSections = enabled
+}
+
+// UseRSSBaseURL changes the base URL RSS feeds are fetched from, e.g. to a mirror or a local file server.
+func (c *Client) UseRSSBaseURL(baseURL string) {
+	c.rssBaseURL = strings.TrimSuffix(baseURL, "/")
+}
+
+// KeyUsage reports the usage of every API key of the client, identifying the keys by their fingerprint.
+func (c *Client) KeyUsage() ([]KeyUsage, error) {
+	usage, err := c.port.APIKeys.Usage()
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// KeyFingerprint identifies an API key in a KeyUsage without revealing it.
+func KeyFingerprint(key string) string {
+	return port.KeyFingerprint(key)
+}
+
+// RedactAPIKeys scrubs the given keys and the value of any api-key query parameter from a text,
+// e.g. an error message or URL, so that it can be shared safely.
+func RedactAPIKeys(text string, keys ...string) string {
+	return port.RedactAPIKeys(text, keys...)
+}
+
+// Redact scrubs the API keys of the client from a text, e.g. a request logged by a RequestHook or a debug dump.
+// Errors returned by the client are redacted already.
+func (c *Client) Redact(text string) string {
+	return c.port.APIKeys.Redact(text)
+}
+
+// TopStoriesSections returns the 'Top stories' sections of this client. In live mode these are the known sections
+// published in the cached section list, in case of an error fetching the section list, or if it names none of them,
+// the known sections are returned, alongside the error if any.
+func (c *Client) TopStoriesSections(ctx context.Context) ([]TopStoriesSection, error) {
+	if !c.liveSections {
+		return TopStoriesSections(), nil
+	}
+
+	sections, err := c.sectionList.get(ctx, c.FetchSectionList)
+	if err != nil {
+		return TopStoriesSections(), err
+	}
+
+	published := map[TopStoriesSection]bool{}
+	for _, section := range liveTopStoriesSections(sections) {
+		published[section] = true
+	}
+	discovered := []TopStoriesSection{}
+	for _, section := range topStoriesSections {
+		if published[section] {
+			discovered = append(discovered, section)
+		}
+	}
+	if len(discovered) == 0 {
+		return TopStoriesSections(), nil
+	}
+	return discovered, nil
+}
+
 // FetchTopStories is used to fetch the 'Top stories' from the New York Times API.
 func (c *Client) FetchTopStories(ctx context.Context, section TopStoriesSection) (*[]Article, *time.Time, error) {
-	if err := section.IsValid(); err != nil {
+	section, err := c.resolveTopStoriesSection(ctx, section)
+	if err != nil {
 		return nil, nil, err
 	}
 
@@ -105,3 +263,655 @@ func (c *Client) FetchMostPopularArticles(ctx context.Context, popularCategory M
 
 	return articles, nil
 }
+
+// FetchMostShared is used to fetch the articles most shared via the given share type from the New York Times API.
+// An empty share type fetches the articles most shared via any share type.
+func (c *Client) FetchMostShared(ctx context.Context, period MostPopularPeriod, shareType MostPopularShareType) (*[]PopularArticle, error) {
+	if shareType == "" {
+		return c.FetchMostPopularArticles(ctx, Shared, period)
+	}
+	if err := period.IsValid(); err != nil {
+		return nil, err
+	}
+	if err := shareType.IsValid(); err != nil {
+		return nil, err
+	}
+
+	fetchMostShared := query.FetchMostShared{
+		Period:    int(period),
+		ShareType: string(shareType),
+	}
+	handler := query.FetchMostSharedHandler{
+		Query: fetchMostShared,
+		Port:  c.port,
+	}
+
+	articles, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return articles, nil
+}
+
+// SearchArticles is used to search articles via the 'Article search' of the New York Times API.
+func (c *Client) SearchArticles(ctx context.Context, searchQuery ArticleSearchQuery) (*[]SearchDocument, *SearchMeta, error) {
+	if err := searchQuery.IsValid(); err != nil {
+		return nil, nil, err
+	}
+
+	searchArticles := query.SearchArticles{
+		Query:       searchQuery.Query,
+		FilterQuery: searchQuery.FilterQuery,
+		BeginDate:   formatArticleSearchDate(searchQuery.BeginDate),
+		EndDate:     formatArticleSearchDate(searchQuery.EndDate),
+		Sort:        string(searchQuery.Sort),
+		Page:        searchQuery.Page,
+		Fields:      searchQuery.Fields,
+	}
+	handler := query.SearchArticlesHandler{
+		Query: searchArticles,
+		Port:  c.port,
+	}
+
+	documents, meta, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return documents, meta, nil
+}
+
+// FetchArchive is used to fetch all articles of a month from the 'Archive' of the New York Times API.
+// Documents are streamed one by one into handleDocument instead of being collected in memory,
+// returning an error from handleDocument stops the processing and is passed on to the caller.
+// A timeout set with WithDefaultTimeout covers the whole stream including all calls of handleDocument,
+// use WithDefaultTimeout(0) or a context with a suitable deadline for large months.
+func (c *Client) FetchArchive(ctx context.Context, year int, month time.Month, handleDocument func(ArchiveDocument) error) error {
+	if err := isValidArchiveMonth(year, month); err != nil {
+		return err
+	}
+
+	fetchArchive := query.FetchArchive{
+		Year:  year,
+		Month: int(month),
+	}
+	handler := query.FetchArchiveHandler{
+		Query: fetchArchive,
+		Port:  c.port,
+	}
+
+	return handler.Handle(ctx, handleDocument)
+}
+
+// FetchBestsellerList is used to fetch a bestseller list from the New York Times API.
+// The list is identified by its encoded name, e.g. 'hardcover-fiction', a zero date fetches the current list.
+// List and date are validated against the published list names, which are fetched once per client.
+func (c *Client) FetchBestsellerList(ctx context.Context, listName string, date time.Time) (*BestsellerList, error) {
+	listNames, err := c.bestsellerListNames.get(ctx, c.FetchBestsellerListNames)
+	if err != nil {
+		return nil, err
+	}
+	if err := isValidBestsellerList(listNames, listName, date); err != nil {
+		return nil, err
+	}
+
+	fetchBestsellerList := query.FetchBestsellerList{
+		ListName: listName,
+		Date:     formatBestsellerDate(date),
+	}
+	handler := query.FetchBestsellerListHandler{
+		Query: fetchBestsellerList,
+		Port:  c.port,
+	}
+
+	bestsellerList, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return bestsellerList, nil
+}
+
+// FetchBestsellerListNames is used to fetch the names of all bestseller lists from the New York Times API.
+func (c *Client) FetchBestsellerListNames(ctx context.Context) (*[]BestsellerListName, error) {
+	handler := query.FetchBestsellerListNamesHandler{
+		Query: query.FetchBestsellerListNames{},
+		Port:  c.port,
+	}
+
+	listNames, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return listNames, nil
+}
+
+// FetchBestsellerOverview is used to fetch all bestseller lists with their top books for a published date
+// from the New York Times API in a single request. A zero date fetches the latest overview.
+func (c *Client) FetchBestsellerOverview(ctx context.Context, publishedDate time.Time) (*BestsellerOverview, error) {
+	fetchBestsellerOverview := query.FetchBestsellerOverview{}
+	if !publishedDate.IsZero() {
+		fetchBestsellerOverview.PublishedDate = publishedDate.Format(bestsellerDateFormat)
+	}
+	handler := query.FetchBestsellerOverviewHandler{
+		Query: fetchBestsellerOverview,
+		Port:  c.port,
+	}
+
+	overview, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return overview, nil
+}
+
+// FetchBestsellerHistory is used to look up the bestseller history of books from the New York Times API.
+// Besides a page of books, each with the ranks it held on any list, the total number of matching books is returned.
+func (c *Client) FetchBestsellerHistory(ctx context.Context, historyQuery BestsellerHistoryQuery) (*[]BestsellerHistory, int, error) {
+	if err := historyQuery.IsValid(); err != nil {
+		return nil, 0, err
+	}
+
+	fetchBestsellerHistory := query.FetchBestsellerHistory{
+		Author:    historyQuery.Author,
+		Title:     historyQuery.Title,
+		Isbn:      historyQuery.Isbn,
+		Publisher: historyQuery.Publisher,
+		AgeGroup:  historyQuery.AgeGroup,
+		Offset:    historyQuery.Offset,
+	}
+	handler := query.FetchBestsellerHistoryHandler{
+		Query: fetchBestsellerHistory,
+		Port:  c.port,
+	}
+
+	history, numResults, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return history, numResults, nil
+}
+
+// SearchMovieReviews is used to search the 'Movie reviews' of the New York Times API.
+// Besides a page of reviews it is returned whether further reviews are available beyond this page.
+func (c *Client) SearchMovieReviews(ctx context.Context, reviewQuery MovieReviewQuery) (*[]MovieReview, bool, error) {
+	if err := reviewQuery.IsValid(); err != nil {
+		return nil, false, err
+	}
+
+	searchMovieReviews := query.SearchMovieReviews{
+		Query:           reviewQuery.Query,
+		Reviewer:        reviewQuery.Reviewer,
+		CriticsPick:     reviewQuery.CriticsPick,
+		OpeningDate:     formatMovieReviewDateRange(reviewQuery.OpeningDateFrom, reviewQuery.OpeningDateTo),
+		PublicationDate: formatMovieReviewDateRange(reviewQuery.PublicationDateFrom, reviewQuery.PublicationDateTo),
+		Order:           string(reviewQuery.Order),
+		Offset:          reviewQuery.Offset,
+	}
+	handler := query.SearchMovieReviewsHandler{
+		Query: searchMovieReviews,
+		Port:  c.port,
+	}
+
+	reviews, hasMore, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return reviews, hasMore, nil
+}
+
+// FetchCriticsPicks is used to fetch the movie reviews marked as critics' picks from the New York Times API.
+// An empty order leaves the ordering to the API, the offset has to be a multiple of MovieReviewsPageSize.
+func (c *Client) FetchCriticsPicks(ctx context.Context, order MovieReviewOrder, offset int) (*[]MovieReview, bool, error) {
+	if order != "" {
+		if err := order.IsValid(); err != nil {
+			return nil, false, err
+		}
+	}
+	if err := isValidMovieReviewOffset(offset); err != nil {
+		return nil, false, err
+	}
+
+	fetchCriticsPicks := query.FetchCriticsPicks{
+		Order:  string(order),
+		Offset: offset,
+	}
+	handler := query.FetchCriticsPicksHandler{
+		Query: fetchCriticsPicks,
+		Port:  c.port,
+	}
+
+	reviews, hasMore, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return reviews, hasMore, nil
+}
+
+// FetchCritics is used to fetch the profiles of movie critics from the New York Times API.
+// The reviewer is either one of AllCritics, FullTimeCritics, PartTimeCritics or the name of a single critic,
+// an empty reviewer fetches all critics.
+func (c *Client) FetchCritics(ctx context.Context, reviewer string) (*[]Critic, error) {
+	if reviewer == "" {
+		reviewer = AllCritics
+	}
+
+	fetchCritics := query.FetchCritics{
+		Reviewer: reviewer,
+	}
+	handler := query.FetchCriticsHandler{
+		Query: fetchCritics,
+		Port:  c.port,
+	}
+
+	critics, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return critics, nil
+}
+
+// FetchWireContent is used to fetch the latest articles from the 'Times wire' of the New York Times API.
+// An empty section fetches all sections, a limit of 0 leaves the number of articles to the API.
+func (c *Client) FetchWireContent(ctx context.Context, source WireSource, section string, limit int, offset int) (*[]WireArticle, error) {
+	return c.FetchWireContentWithin(ctx, source, section, 0, limit, offset)
+}
+
+// FetchWireContentWithin is used to fetch the articles published within the given time period
+// from the 'Times wire' of the New York Times API. The time period is rounded up to whole hours,
+// a time period of 0 applies no window at all.
+func (c *Client) FetchWireContentWithin(ctx context.Context, source WireSource, section string, timePeriod time.Duration, limit int, offset int) (*[]WireArticle, error) {
+	if err := source.IsValid(); err != nil {
+		return nil, err
+	}
+	if err := isValidWireLimitAndOffset(limit, offset); err != nil {
+		return nil, err
+	}
+	hours, err := wireTimePeriodHours(timePeriod)
+	if err != nil {
+		return nil, err
+	}
+	if section == "" {
+		section = AllSections
+	}
+
+	fetchWireContent := query.FetchWireContent{
+		Source:     string(source),
+		Section:    section,
+		TimePeriod: hours,
+		Limit:      limit,
+		Offset:     offset,
+	}
+	handler := query.FetchWireContentHandler{
+		Query: fetchWireContent,
+		Port:  c.port,
+	}
+
+	articles, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return articles, nil
+}
+
+// FetchSectionList is used to fetch the list of sections of the 'Times wire' from the New York Times API.
+func (c *Client) FetchSectionList(ctx context.Context) (*[]WireSection, error) {
+	handler := query.FetchSectionListHandler{
+		Query: query.FetchSectionList{},
+		Port:  c.port,
+	}
+
+	sections, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sections, nil
+}
+
+// Validates a 'Top stories' section, in live mode resolving differently spelled sections
+// of the section list to the section expected by the API, e.g. "u.s." to "us".
+func (c *Client) resolveTopStoriesSection(ctx context.Context, section TopStoriesSection) (TopStoriesSection, error) {
+	err := section.IsValid()
+	if err == nil || !c.liveSections {
+		return section, err
+	}
+
+	sections, fetchErr := c.sectionList.get(ctx, c.FetchSectionList)
+	if fetchErr != nil {
+		return section, err
+	}
+	if resolved, ok := liveTopStoriesSections(sections)[normalizeSection(string(section))]; ok {
+		return resolved, nil
+	}
+	return section, err
+}
+
+// FetchWireByURL is used to look up a single article by its URL via the 'Times wire' of the New York Times API.
+// Query parameters and fragments of the URL are dropped before the lookup.
+func (c *Client) FetchWireByURL(ctx context.Context, articleURL string) (*Article, error) {
+	normalizedURL, err := normalizeArticleURL(articleURL)
+	if err != nil {
+		return nil, err
+	}
+
+	fetchWireByURL := query.FetchWireByURL{
+		URL: normalizedURL,
+	}
+	handler := query.FetchWireByURLHandler{
+		Query: fetchWireByURL,
+		Port:  c.port,
+	}
+
+	articles, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(*articles) == 0 {
+		return nil, fmt.Errorf("no article found for url: %v", normalizedURL)
+	}
+
+	article := (*articles)[0].Article()
+	return &article, nil
+}
+
+// SearchConcepts is used to search the concepts of the 'Semantic' New York Times API by name.
+// An empty concept type returns concepts of any type, otherwise only concepts of the given type are returned.
+func (c *Client) SearchConcepts(ctx context.Context, searchQuery string, conceptType ConceptType) (*[]Concept, error) {
+	if conceptType != "" {
+		if err := conceptType.IsValid(); err != nil {
+			return nil, err
+		}
+	}
+	if searchQuery == "" {
+		return nil, fmt.Errorf("invalid concept search query: query must not be empty")
+	}
+
+	searchConcepts := query.SearchConcepts{
+		Query: searchQuery,
+	}
+	handler := query.SearchConceptsHandler{
+		Query: searchConcepts,
+		Port:  c.port,
+	}
+
+	concepts, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if conceptType == "" {
+		return concepts, nil
+	}
+
+	// The API does not filter by type when searching, hence concepts of other types are dropped here.
+	filtered := []Concept{}
+	for _, concept := range *concepts {
+		if concept.ConceptType == string(conceptType) {
+			filtered = append(filtered, concept)
+		}
+	}
+	return &filtered, nil
+}
+
+// FetchConcept is used to fetch a single concept by type and name from the 'Semantic' New York Times API.
+// Fields request additional information such as links, geocodes or the article list of the concept.
+func (c *Client) FetchConcept(ctx context.Context, conceptType ConceptType, specificConcept string, fields []ConceptField) (*Concept, error) {
+	if err := conceptType.IsValid(); err != nil {
+		return nil, err
+	}
+	if specificConcept == "" {
+		return nil, fmt.Errorf("invalid concept: name must not be empty")
+	}
+	conceptFields := []string{}
+	for _, field := range fields {
+		if err := field.IsValid(); err != nil {
+			return nil, err
+		}
+		conceptFields = append(conceptFields, string(field))
+	}
+
+	fetchConcept := query.FetchConcept{
+		ConceptType:     string(conceptType),
+		SpecificConcept: specificConcept,
+		Fields:          conceptFields,
+	}
+	handler := query.FetchConceptHandler{
+		Query: fetchConcept,
+		Port:  c.port,
+	}
+
+	concepts, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(*concepts) == 0 {
+		return nil, fmt.Errorf("no concept found for %v: %v", conceptType, specificConcept)
+	}
+
+	return &(*concepts)[0], nil
+}
+
+// SuggestTags is used to fetch canonical tags of the 'TimesTags' New York Times API matching the given query.
+// Filters narrow the suggestions down to the given tag types, no filters suggest tags of any type.
+// A max of 0 leaves the number of suggestions to the API.
+func (c *Client) SuggestTags(ctx context.Context, tagQuery string, filters []TagFilter, max int) (*[]TimesTag, error) {
+	if tagQuery == "" {
+		return nil, fmt.Errorf("invalid tag query: query must not be empty")
+	}
+	if max < 0 || max > TimesTagsMaxSuggestions {
+		return nil, fmt.Errorf("invalid tag max: %v, must be between 0 and %v", max, TimesTagsMaxSuggestions)
+	}
+	filter, err := formatTagFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestTags := query.SuggestTags{
+		Query:  tagQuery,
+		Filter: filter,
+		Max:    max,
+	}
+	handler := query.SuggestTagsHandler{
+		Query: suggestTags,
+		Port:  c.port,
+	}
+
+	tags, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// FetchComments is used to fetch a page of comments on an article from the 'Community' New York Times API.
+// Only the first replies of each comment are included, FetchCommentReplies or IterateComments provide the rest.
+// An empty sort leaves the order to the API, the offset has to be a multiple of CommentsPageSize.
+func (c *Client) FetchComments(ctx context.Context, articleURL string, offset int, sort CommentSort) (*[]Comment, *CommentsMeta, error) {
+	normalizedURL, err := normalizeArticleURL(articleURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := isValidCommentOffset(offset); err != nil {
+		return nil, nil, err
+	}
+	if sort != "" {
+		if err := sort.IsValid(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	fetchComments := query.FetchComments{
+		URL:    normalizedURL,
+		Offset: offset,
+		Sort:   string(sort),
+	}
+	handler := query.FetchCommentsHandler{
+		Query: fetchComments,
+		Port:  c.port,
+	}
+
+	comments, meta, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return comments, meta, nil
+}
+
+// FetchCommentReplies is used to fetch a page of replies to a comment, identified by its sequence,
+// from the 'Community' New York Times API. The offset counts the replies to be skipped.
+func (c *Client) FetchCommentReplies(ctx context.Context, articleURL string, commentSequence int, offset int) (*[]Comment, *CommentsMeta, error) {
+	normalizedURL, err := normalizeArticleURL(articleURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	if commentSequence <= 0 {
+		return nil, nil, fmt.Errorf("invalid comment sequence: %v", commentSequence)
+	}
+	if offset < 0 {
+		return nil, nil, fmt.Errorf("invalid comment reply offset: %v", offset)
+	}
+
+	fetchCommentReplies := query.FetchCommentReplies{
+		URL:             normalizedURL,
+		CommentSequence: commentSequence,
+		Offset:          offset,
+	}
+	handler := query.FetchCommentRepliesHandler{
+		Query: fetchCommentReplies,
+		Port:  c.port,
+	}
+
+	replies, meta, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return replies, meta, nil
+}
+
+// FetchGeo is used to look up places by name via the 'Geographic' New York Times API.
+func (c *Client) FetchGeo(ctx context.Context, geoQuery string) (*[]Geo, error) {
+	if geoQuery == "" {
+		return nil, fmt.Errorf("invalid geo query: query must not be empty")
+	}
+
+	return c.fetchGeo(ctx, geoQuery, 0)
+}
+
+// ResolveGeoFacets is used to resolve geo facets of articles, such as "Brooklyn (NYC)", into the places
+// of the 'Geographic' New York Times API. Each facet is looked up once per client and cached afterwards,
+// facets without a matching place are left out of the result.
+func (c *Client) ResolveGeoFacets(ctx context.Context, facets []string) (map[string]Geo, error) {
+	places := map[string]Geo{}
+	for _, facet := range facets {
+		if facet == "" {
+			continue
+		}
+
+		place, err := c.geoFacets.get(ctx, facet, c.fetchGeoFacet)
+		if err != nil {
+			return nil, err
+		}
+		if place != nil {
+			places[facet] = *place
+		}
+	}
+
+	return places, nil
+}
+
+func (c *Client) fetchGeoFacet(ctx context.Context, facet string) (*Geo, error) {
+	places, err := c.fetchGeo(ctx, facet, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(*places) == 0 {
+		return nil, nil
+	}
+
+	return &(*places)[0], nil
+}
+
+func (c *Client) fetchGeo(ctx context.Context, geoQuery string, limit int) (*[]Geo, error) {
+	fetchGeo := query.FetchGeo{
+		Query: geoQuery,
+		Limit: limit,
+	}
+	handler := query.FetchGeoHandler{
+		Query: fetchGeo,
+		Port:  c.port,
+	}
+
+	places, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return places, nil
+}
+
+// DoRaw is used to request any endpoint of the New York Times API, including those not wrapped by this client yet.
+// The path is given relative to https://api.nytimes.com/svc, e.g. "books/v3/lists/names.json", the API key is added
+// to the parameters. Failed requests result in the same errors as for all other endpoints, otherwise the status,
+// headers and unparsed body of the response are returned.
+func (c *Client) DoRaw(ctx context.Context, rawPath string, params url.Values) (*RawResponse, error) {
+	normalizedPath, normalizedParams, err := normalizeRawPath(rawPath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	fetchRaw := query.FetchRaw{
+		Path:   normalizedPath,
+		Params: normalizedParams,
+	}
+	handler := query.FetchRawHandler{
+		Query: fetchRaw,
+		Port:  c.port,
+	}
+
+	rawResponse, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse, nil
+}
+
+// FetchRSSFeed is used to fetch one of the public RSS feeds of the New York Times, e.g. "World" or "Technology".
+// Items of the feed are converted into articles, which are returned along with the time the feed was last updated.
+// RSS feeds do not require an API key, hence none is sent along.
+func (c *Client) FetchRSSFeed(ctx context.Context, feed string) (*[]Article, *time.Time, error) {
+	feed, err := normalizeRSSFeed(feed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fetchRSSFeed := query.FetchRSSFeed{
+		Feed: feed,
+	}
+	// The feed server shares the HTTP settings of the API, but neither receives the API key nor counts against its quota.
+	rssPort := c.port
+	rssPort.BaseURL = c.rssBaseURL
+	rssPort.APIKeys = nil
+	handler := query.FetchRSSFeedHandler{
+		Query: fetchRSSFeed,
+		Port:  rssPort,
+	}
+
+	articles, lastUpdated, err := handler.Handle(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return articles, lastUpdated, nil
+}
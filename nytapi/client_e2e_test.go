@@ -82,3 +82,244 @@ func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchMostPopu
 	require.Nil(suite.T(), err)
 	assert.NotNil(suite.T(), articles)
 }
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchMostShared_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+	period := nytapi.Day
+	shareType := nytapi.Facebook
+
+	articles, err := sut.FetchMostShared(ctx, period, shareType)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), articles)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_SearchArticles_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+	searchQuery := nytapi.ArticleSearchQuery{
+		Query: "election",
+		Sort:  nytapi.Newest,
+	}
+
+	documents, meta, err := sut.SearchArticles(ctx, searchQuery)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), documents)
+	assert.NotNil(suite.T(), meta)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchArchive_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+	documents := 0
+
+	err := sut.FetchArchive(ctx, 1969, time.July, func(document nytapi.ArchiveDocument) error {
+		documents++
+		return nil
+	})
+
+	require.Nil(suite.T(), err)
+	assert.NotZero(suite.T(), documents)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchBestsellerList_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	bestsellerList, err := sut.FetchBestsellerList(ctx, "hardcover-fiction", time.Time{})
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), bestsellerList)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchBestsellerListNames_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	listNames, err := sut.FetchBestsellerListNames(ctx)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), listNames)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchBestsellerOverview_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	overview, err := sut.FetchBestsellerOverview(ctx, time.Time{})
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), overview)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchBestsellerHistory_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+	historyQuery := nytapi.BestsellerHistoryQuery{
+		Author: "Michelle Obama",
+	}
+
+	history, _, err := sut.FetchBestsellerHistory(ctx, historyQuery)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), history)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_SearchMovieReviews_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+	reviewQuery := nytapi.MovieReviewQuery{
+		Query: "lebowski",
+	}
+
+	reviews, _, err := sut.SearchMovieReviews(ctx, reviewQuery)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), reviews)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchCriticsPicks_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	reviews, _, err := sut.FetchCriticsPicks(ctx, nytapi.ByPublicationDate, 0)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), reviews)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchCritics_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	critics, err := sut.FetchCritics(ctx, nytapi.FullTimeCritics)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), critics)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchWireContent_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	articles, err := sut.FetchWireContent(ctx, nytapi.NYT, nytapi.AllSections, 20, 0)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), articles)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchWireContentWithin_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	articles, err := sut.FetchWireContentWithin(ctx, nytapi.AllSources, "world", 24*time.Hour, 20, 0)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), articles)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchSectionList_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	sections, err := sut.FetchSectionList(ctx)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), sections)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchWireByURL_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	article, err := sut.FetchWireByURL(ctx, "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html")
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), article)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_SearchConcepts_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	concepts, err := sut.SearchConcepts(ctx, "obama", nytapi.ConceptTypePerson)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), concepts)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchConcept_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+	fields := []nytapi.ConceptField{nytapi.ConceptFieldLinks, nytapi.ConceptFieldGeocodes}
+
+	concept, err := sut.FetchConcept(ctx, nytapi.ConceptTypeGeographic, "Paris (France)", fields)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), concept)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_SuggestTags_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+	filters := []nytapi.TagFilter{nytapi.TagFilterPerson}
+
+	tags, err := sut.SuggestTags(ctx, "obama", filters, 5)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), tags)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchComments_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+	articleURL := "https://www.nytimes.com/2019/06/21/science/giant-squid-cephalopod-video.html"
+
+	comments, meta, err := sut.FetchComments(ctx, articleURL, 0, nytapi.CommentSortNewest)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), comments)
+	assert.NotNil(suite.T(), meta)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_FetchGeo_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	places, err := sut.FetchGeo(ctx, "Brooklyn")
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), places)
+}
+
+func (suite *ClientE2ETestSuite) Test_E2E_Client_ShouldHandleValid_DoRaw_WithValues() {
+	sut := nytapi.NewClient(&suite.httpClient, suite.apiKey)
+
+	ctx := context.Background()
+
+	rawResponse, err := sut.DoRaw(ctx, "books/v3/lists/names.json", nil)
+
+	require.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), rawResponse)
+}
@@ -3,9 +3,13 @@ package nytapi_test
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -348,3 +352,1988 @@ func Test_Client_ShouldHandleInvalidMostPopularPeriod_FetchMostPopularArticles_w
 	require.Nil(t, articles)
 	assert.NotNil(t, err)
 }
+
+func Test_Client_ShouldHandleValid_SearchArticles_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company. All Rights Reserved.",
+				"response": {
+					"docs": [
+						{
+						"abstract": "The rover has been exploring Jezero Crater since February.",
+						"web_url": "https://www.nytimes.com/2021/06/01/science/mars-perseverance-rover.html",
+						"headline": {
+							"main": "Perseverance Begins Its Science Campaign on Mars"
+						},
+						"keywords": [
+							{
+							"name": "subject",
+							"value": "Mars (Planet)",
+							"rank": 1,
+							"major": "N"
+							}
+						],
+						"pub_date": "2021-06-01T15:00:07+0000",
+						"byline": {
+							"original": "By Kenneth Chang"
+						},
+						"_id": "nyt://article/00000000-0000-0000-0000-000000000000"
+						}
+					],
+					"meta": {
+						"hits": 1,
+						"offset": 0,
+						"time": 27
+					}
+				}
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	searchQuery := nytapi.ArticleSearchQuery{
+		Query: "mars",
+		Sort:  nytapi.Newest,
+	}
+	documents, meta, err := sut.SearchArticles(ctx, searchQuery)
+
+	require.Nil(t, err)
+	assert.NotNil(t, documents)
+	assert.NotNil(t, meta)
+}
+
+func Test_Client_ShouldHandleInvalid_SearchArticles_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	searchQuery := nytapi.ArticleSearchQuery{
+		Query: "mars",
+	}
+	documents, meta, err := sut.SearchArticles(ctx, searchQuery)
+
+	require.Nil(t, documents)
+	require.Nil(t, meta)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidArticleSearchQuery_SearchArticles_withError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	searchQuery := nytapi.ArticleSearchQuery{
+		Query: "mars",
+		Sort:  nytapi.ArticleSearchSort("not-a-valid-sort"),
+	}
+	documents, meta, err := sut.SearchArticles(ctx, searchQuery)
+
+	require.Nil(t, documents)
+	require.Nil(t, meta)
+	assert.NotNil(t, err)
+}
+
+func Test_Client_ShouldHandleValid_FetchArchive_WithValues(t *testing.T) {
+	json := `{
+				"copyright": "Copyright (c) 2021 The New York Times Company. All Rights Reserved.",
+				"response": {
+					"meta": {
+						"hits": 1
+					},
+					"docs": [
+						{
+						"web_url": "https://www.nytimes.com/1969/07/21/archives/men-walk-on-moon.html",
+						"headline": {
+							"main": "MEN WALK ON MOON"
+						},
+						"pub_date": "1969-07-21T05:00:00+0000",
+						"_id": "nyt://article/00000000-0000-0000-0000-000000000001"
+						}
+					]
+				}
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	var documents []nytapi.ArchiveDocument
+	err := sut.FetchArchive(ctx, 1969, time.July, func(document nytapi.ArchiveDocument) error {
+		documents = append(documents, document)
+		return nil
+	})
+
+	require.Nil(t, err)
+	assert.Len(t, documents, 1)
+}
+
+func Test_Client_ShouldHandleInvalid_FetchArchive_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	err := sut.FetchArchive(ctx, 1969, time.July, func(document nytapi.ArchiveDocument) error {
+		return nil
+	})
+
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidArchiveMonth_FetchArchive_withError(t *testing.T) {
+	var cases = []struct {
+		year  int
+		month time.Month
+	}{
+		{1851, time.August},
+		{1969, time.Month(0)},
+		{1969, time.Month(13)},
+		{time.Now().Year() + 1, time.January},
+	}
+
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		err := sut.FetchArchive(ctx, tt.year, tt.month, func(document nytapi.ArchiveDocument) error {
+			return nil
+		})
+
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 0, requests)
+}
+
+const bestsellerListNamesJSON = `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 1,
+				"results": [
+					{
+					"list_name": "Hardcover Fiction",
+					"display_name": "Hardcover Fiction",
+					"list_name_encoded": "hardcover-fiction",
+					"oldest_published_date": "2008-06-08",
+					"newest_published_date": "2021-06-27",
+					"updated": "WEEKLY"
+					}
+				]
+			}`
+
+func Test_Client_ShouldHandleValid_FetchBestsellerList_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 1,
+				"results": {
+					"list_name": "Hardcover Fiction",
+					"list_name_encoded": "hardcover-fiction",
+					"published_date": "2021-06-27",
+					"display_name": "Hardcover Fiction",
+					"books": [
+						{
+						"rank": 1,
+						"rank_last_week": 2,
+						"weeks_on_list": 3,
+						"price": 0,
+						"title": "THE CHRISTMAS PIG",
+						"author": "J.K. Rowling",
+						"amazon_product_url": "https://www.amazon.com/dp/1538719843?tag=NYTBSREV-20"
+						}
+					]
+				}
+			}`
+
+	var requestedURLs []string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURLs = append(requestedURLs, req.URL.Path)
+			body := json
+			if strings.HasSuffix(req.URL.Path, "/names.json") {
+				body = bestsellerListNamesJSON
+			}
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	date := time.Date(2021, time.June, 27, 0, 0, 0, 0, time.UTC)
+	bestsellerList, err := sut.FetchBestsellerList(ctx, "hardcover-fiction", date)
+	require.Nil(t, err)
+	assert.NotNil(t, bestsellerList)
+
+	bestsellerList, err = sut.FetchBestsellerList(ctx, "hardcover-fiction", time.Time{})
+	require.Nil(t, err)
+	assert.NotNil(t, bestsellerList)
+
+	assert.Equal(t, []string{
+		"/svc/books/v3/lists/names.json",
+		"/svc/books/v3/lists/2021-06-27/hardcover-fiction.json",
+		"/svc/books/v3/lists/current/hardcover-fiction.json",
+	}, requestedURLs)
+}
+
+func Test_Client_ShouldHandleInvalid_FetchBestsellerList_WithError(t *testing.T) {
+	var cases = []struct {
+		namesStatusCode int
+		listStatusCode  int
+	}{
+		{404, 200},
+		{200, 404},
+	}
+
+	for _, tt := range cases {
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				if strings.HasSuffix(req.URL.Path, "/names.json") {
+					return &http.Response{StatusCode: tt.namesStatusCode, Body: ioutil.NopCloser(strings.NewReader(bestsellerListNamesJSON))}, nil
+				}
+				return &http.Response{StatusCode: tt.listStatusCode}, nil
+			},
+		}
+		apiKey := "mockedApiKey"
+		sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+		ctx := context.Background()
+		bestsellerList, err := sut.FetchBestsellerList(ctx, "hardcover-fiction", time.Time{})
+
+		require.Nil(t, bestsellerList)
+		if assert.NotNil(t, err) {
+			assert.IsType(t, apierror.APIError{}, err)
+		}
+	}
+}
+
+func Test_Client_ShouldHandleInvalidListOrDate_FetchBestsellerList_withError(t *testing.T) {
+	var cases = []struct {
+		listName string
+		date     time.Time
+	}{
+		{"", time.Time{}},
+		{"not-a-list", time.Time{}},
+		{"Hardcover Fiction", time.Time{}},
+		{"hardcover-fiction", time.Date(2008, time.June, 7, 0, 0, 0, 0, time.UTC)},
+		{"hardcover-fiction", time.Date(2021, time.June, 28, 0, 0, 0, 0, time.UTC)},
+	}
+
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(bestsellerListNamesJSON))}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		bestsellerList, err := sut.FetchBestsellerList(ctx, tt.listName, tt.date)
+
+		require.Nil(t, bestsellerList)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 1, requests)
+}
+
+func Test_Client_ShouldHandleValid_FetchBestsellerListNames_WithValues(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(bestsellerListNamesJSON))}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	listNames, err := sut.FetchBestsellerListNames(ctx)
+
+	require.Nil(t, err)
+	assert.NotNil(t, listNames)
+}
+
+func Test_Client_ShouldHandleInvalid_FetchBestsellerListNames_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	listNames, err := sut.FetchBestsellerListNames(ctx)
+
+	require.Nil(t, listNames)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleValid_FetchBestsellerOverview_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 1,
+				"results": {
+					"published_date": "2021-06-27",
+					"lists": [
+						{
+						"list_id": 1,
+						"list_name": "Hardcover Fiction",
+						"list_name_encoded": "hardcover-fiction",
+						"display_name": "Hardcover Fiction",
+						"updated": "WEEKLY",
+						"books": [
+							{
+							"rank": 1,
+							"title": "THE LAST THING HE TOLD ME",
+							"author": "Laura Dave"
+							}
+						]
+						}
+					]
+				}
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	var requestedURL string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL.String()
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	date := time.Date(2021, time.June, 27, 0, 0, 0, 0, time.UTC)
+	overview, err := sut.FetchBestsellerOverview(ctx, date)
+
+	require.Nil(t, err)
+	assert.NotNil(t, overview)
+	assert.Contains(t, requestedURL, "/books/v3/lists/overview.json?")
+	assert.Contains(t, requestedURL, "published_date=2021-06-27")
+}
+
+func Test_Client_ShouldHandleInvalid_FetchBestsellerOverview_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	overview, err := sut.FetchBestsellerOverview(ctx, time.Time{})
+
+	require.Nil(t, overview)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleValid_FetchBestsellerHistory_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 21,
+				"results": [
+					{
+					"title": "WHERE THE CRAWDADS SING",
+					"author": "Delia Owens",
+					"publisher": "Putnam",
+					"ranks_history": [
+						{
+						"rank": 3,
+						"list_name": "Hardcover Fiction",
+						"display_name": "Hardcover Fiction",
+						"published_date": "2021-06-27",
+						"weeks_on_list": 141
+						}
+					]
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	var requestedURL string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL.String()
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	historyQuery := nytapi.BestsellerHistoryQuery{
+		Author:   "Delia Owens",
+		AgeGroup: "adult",
+		Offset:   20,
+	}
+	history, numResults, err := sut.FetchBestsellerHistory(ctx, historyQuery)
+
+	require.Nil(t, err)
+	assert.NotNil(t, history)
+	assert.Equal(t, 21, numResults)
+	assert.Contains(t, requestedURL, "/books/v3/lists/best-sellers/history.json?")
+	assert.Contains(t, requestedURL, "author=Delia+Owens")
+	assert.Contains(t, requestedURL, "age-group=adult")
+	assert.Contains(t, requestedURL, "offset=20")
+}
+
+func Test_Client_ShouldHandleInvalid_FetchBestsellerHistory_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	historyQuery := nytapi.BestsellerHistoryQuery{
+		Author: "Delia Owens",
+	}
+	history, numResults, err := sut.FetchBestsellerHistory(ctx, historyQuery)
+
+	require.Nil(t, history)
+	assert.Zero(t, numResults)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidBestsellerHistoryQuery_FetchBestsellerHistory_withError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	historyQuery := nytapi.BestsellerHistoryQuery{
+		Author: "Delia Owens",
+		Offset: 5,
+	}
+	history, numResults, err := sut.FetchBestsellerHistory(ctx, historyQuery)
+
+	require.Nil(t, history)
+	assert.Zero(t, numResults)
+	assert.NotNil(t, err)
+}
+
+func Test_Client_ShouldHandleValid_SearchMovieReviews_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company. All Rights Reserved.",
+				"has_more": true,
+				"num_results": 1,
+				"results": [
+					{
+					"display_title": "The Big Lebowski",
+					"critics_pick": 1,
+					"byline": "Janet Maslin",
+					"link": {
+						"type": "article",
+						"url": "https://www.nytimes.com/1998/03/06/movies/film-review-a-bowling-ball-s-eye-view-of-reality.html"
+					}
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	var requestedURL string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL.String()
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	reviewQuery := nytapi.MovieReviewQuery{
+		Query:           "lebowski",
+		CriticsPick:     true,
+		OpeningDateFrom: time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC),
+		OpeningDateTo:   time.Date(1998, time.December, 31, 0, 0, 0, 0, time.UTC),
+		Order:           nytapi.ByTitle,
+		Offset:          20,
+	}
+	reviews, hasMore, err := sut.SearchMovieReviews(ctx, reviewQuery)
+
+	require.Nil(t, err)
+	assert.NotNil(t, reviews)
+	assert.True(t, hasMore)
+	assert.Contains(t, requestedURL, "/movies/v2/reviews/search.json?")
+	assert.Contains(t, requestedURL, "query=lebowski")
+	assert.Contains(t, requestedURL, "critics-pick=Y")
+	assert.Contains(t, requestedURL, "opening-date=1998-01-01%3A1998-12-31")
+	assert.NotContains(t, requestedURL, "publication-date")
+	assert.Contains(t, requestedURL, "order=by-title")
+	assert.Contains(t, requestedURL, "offset=20")
+}
+
+func Test_Client_ShouldHandleInvalid_SearchMovieReviews_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	reviewQuery := nytapi.MovieReviewQuery{
+		Query: "lebowski",
+	}
+	reviews, hasMore, err := sut.SearchMovieReviews(ctx, reviewQuery)
+
+	require.Nil(t, reviews)
+	assert.False(t, hasMore)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidMovieReviewQuery_SearchMovieReviews_withError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	reviewQuery := nytapi.MovieReviewQuery{
+		Query: "lebowski",
+		Order: "by-rating",
+	}
+	reviews, hasMore, err := sut.SearchMovieReviews(ctx, reviewQuery)
+
+	require.Nil(t, reviews)
+	assert.False(t, hasMore)
+	assert.NotNil(t, err)
+}
+
+func Test_Client_ShouldHandleValid_FetchCriticsPicks_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company. All Rights Reserved.",
+				"has_more": false,
+				"num_results": 1,
+				"results": [
+					{
+					"display_title": "The Big Lebowski",
+					"critics_pick": 1
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	var requestedURL string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL.String()
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	reviews, hasMore, err := sut.FetchCriticsPicks(ctx, nytapi.ByOpeningDate, 40)
+
+	require.Nil(t, err)
+	assert.NotNil(t, reviews)
+	assert.False(t, hasMore)
+	assert.Contains(t, requestedURL, "/movies/v2/reviews/picks.json?")
+	assert.Contains(t, requestedURL, "order=by-opening-date")
+	assert.Contains(t, requestedURL, "offset=40")
+}
+
+func Test_Client_ShouldHandleInvalid_FetchCriticsPicks_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	reviews, hasMore, err := sut.FetchCriticsPicks(ctx, "", 0)
+
+	require.Nil(t, reviews)
+	assert.False(t, hasMore)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidOrderOrOffset_FetchCriticsPicks_withError(t *testing.T) {
+	var cases = []struct {
+		order  nytapi.MovieReviewOrder
+		offset int
+	}{
+		{"by-rating", 0},
+		{nytapi.ByTitle, 10},
+		{"", -20},
+	}
+
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		reviews, hasMore, err := sut.FetchCriticsPicks(ctx, tt.order, tt.offset)
+
+		require.Nil(t, reviews)
+		assert.False(t, hasMore)
+		assert.NotNil(t, err)
+	}
+}
+
+func Test_Client_ShouldHandleValid_FetchCritics_WithValues(t *testing.T) {
+	var cases = []struct {
+		reviewer     string
+		expectedPath string
+	}{
+		{"", "/movies/v2/critics/all.json"},
+		{nytapi.PartTimeCritics, "/movies/v2/critics/part-time.json"},
+		{"A. O. Scott", "/movies/v2/critics/A.%20O.%20Scott.json"},
+	}
+
+	for _, tt := range cases {
+		json := `{
+					"status": "OK",
+					"copyright": "Copyright (c) 2021 The New York Times Company. All Rights Reserved.",
+					"num_results": 1,
+					"results": [
+						{
+						"display_name": "A. O. Scott",
+						"status": "full-time"
+						}
+					]
+				}`
+		body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+		var requestedURL string
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				requestedURL = req.URL.String()
+				return &http.Response{StatusCode: 200, Body: body}, nil
+			},
+		}
+		apiKey := "mockedApiKey"
+		sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+		ctx := context.Background()
+		critics, err := sut.FetchCritics(ctx, tt.reviewer)
+
+		require.Nil(t, err)
+		assert.NotNil(t, critics)
+		assert.Contains(t, requestedURL, tt.expectedPath)
+	}
+}
+
+func Test_Client_ShouldHandleInvalid_FetchCritics_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	critics, err := sut.FetchCritics(ctx, nytapi.AllCritics)
+
+	require.Nil(t, critics)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleValid_FetchWireContent_WithValues(t *testing.T) {
+	var cases = []struct {
+		source      nytapi.WireSource
+		section     string
+		timePeriod  time.Duration
+		limit       int
+		offset      int
+		expectedURL string
+	}{
+		{nytapi.AllSources, "", 0, 0, 0, "/news/v3/content/all/all.json?api-key=mockedApiKey&offset=0"},
+		{nytapi.NYT, "world", 0, 50, 20, "/news/v3/content/nyt/world.json?api-key=mockedApiKey&limit=50&offset=20"},
+		{nytapi.INYT, nytapi.AllSections, 24 * time.Hour, 20, 0, "/news/v3/content/inyt/all/24.json?"},
+		{nytapi.NYT, "business", 90 * time.Minute, 0, 0, "/news/v3/content/nyt/business/2.json?"},
+	}
+
+	for _, tt := range cases {
+		json := `{
+					"status": "OK",
+					"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+					"num_results": 1,
+					"results": [
+						{
+						"section": "World",
+						"title": "England Moves to Lift Its Remaining Virus Restrictions",
+						"url": "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html",
+						"source": "New York Times",
+						"org_facet": "",
+						"thumbnail_standard": "https://static01.nyt.com/images/2021/07/05/world/05virus-world/05virus-world-thumbStandard.jpg"
+						}
+					]
+				}`
+		body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+		var requestedURL string
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				requestedURL = req.URL.String()
+				return &http.Response{StatusCode: 200, Body: body}, nil
+			},
+		}
+		apiKey := "mockedApiKey"
+		sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+		ctx := context.Background()
+		articles, err := sut.FetchWireContentWithin(ctx, tt.source, tt.section, tt.timePeriod, tt.limit, tt.offset)
+
+		require.Nil(t, err)
+		assert.NotNil(t, articles)
+		assert.Contains(t, requestedURL, tt.expectedURL)
+	}
+}
+
+func Test_Client_ShouldHandleInvalid_FetchWireContent_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	articles, err := sut.FetchWireContent(ctx, nytapi.NYT, "world", 20, 0)
+
+	require.Nil(t, articles)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidParameters_FetchWireContent_withError(t *testing.T) {
+	var cases = []struct {
+		source     nytapi.WireSource
+		timePeriod time.Duration
+		limit      int
+		offset     int
+	}{
+		{"iht", 0, 20, 0},
+		{nytapi.NYT, 0, 501, 0},
+		{nytapi.NYT, 0, -1, 0},
+		{nytapi.NYT, 0, 20, -20},
+		{nytapi.NYT, -time.Hour, 20, 0},
+		{nytapi.NYT, nytapi.WireMaxTimePeriod + time.Hour, 20, 0},
+	}
+
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		articles, err := sut.FetchWireContentWithin(ctx, tt.source, "world", tt.timePeriod, tt.limit, tt.offset)
+
+		require.Nil(t, articles)
+		assert.NotNil(t, err)
+	}
+}
+
+const sectionListJSON = `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 4,
+				"results": [
+					{
+					"section": "arts",
+					"display_name": "Arts"
+					},
+					{
+					"section": "u.s.",
+					"display_name": "U.S."
+					},
+					{
+					"section": "t magazine",
+					"display_name": "T Magazine"
+					},
+					{
+					"section": "climate",
+					"display_name": "Climate"
+					}
+				]
+			}`
+
+const topStoriesJSON = `{
+				"status": "OK",
+				"section": "climate",
+				"last_updated": "2021-07-05T10:35:08-04:00",
+				"num_results": 0,
+				"results": []
+			}`
+
+func Test_Client_ShouldHandleValid_FetchSectionList_WithValues(t *testing.T) {
+	var requestedURL string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL.String()
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(sectionListJSON))}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	sections, err := sut.FetchSectionList(ctx)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, sections) {
+		assert.Len(t, *sections, 4)
+	}
+	assert.Contains(t, requestedURL, "/news/v3/content/section-list.json?")
+}
+
+func Test_Client_ShouldHandleInvalid_FetchSectionList_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	sections, err := sut.FetchSectionList(ctx)
+
+	require.Nil(t, sections)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldValidateLiveSections_FetchTopStories_WithValues(t *testing.T) {
+	var cases = []struct {
+		section      nytapi.TopStoriesSection
+		expectedPath string
+	}{
+		{nytapi.Arts, "/svc/topstories/v2/arts.json"},
+		{"u.s.", "/svc/topstories/v2/us.json"},
+		{"t magazine", "/svc/topstories/v2/t-magazine.json"},
+	}
+
+	var requestedURLs []string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURLs = append(requestedURLs, req.URL.Path)
+			body := topStoriesJSON
+			if strings.HasSuffix(req.URL.Path, "/section-list.json") {
+				body = sectionListJSON
+			}
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+	sut.UseLiveSections(true)
+
+	for _, tt := range cases {
+		requestedURLs = nil
+		ctx := context.Background()
+		articles, _, err := sut.FetchTopStories(ctx, tt.section)
+
+		require.Nil(t, err)
+		assert.NotNil(t, articles)
+		assert.Equal(t, tt.expectedPath, requestedURLs[len(requestedURLs)-1])
+	}
+
+	for _, section := range []nytapi.TopStoriesSection{"not-a-section", "climate"} {
+		requestedURLs = nil
+		ctx := context.Background()
+		articles, _, err := sut.FetchTopStories(ctx, section)
+
+		require.Nil(t, articles)
+		assert.NotNil(t, err)
+		assert.Empty(t, requestedURLs)
+	}
+}
+
+func Test_Client_ShouldFallBackToKnownSections_FetchTopStories_WithValues(t *testing.T) {
+	var requestedURLs []string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURLs = append(requestedURLs, req.URL.Path)
+			if strings.HasSuffix(req.URL.Path, "/section-list.json") {
+				return nil, fmt.Errorf("offline")
+			}
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(topStoriesJSON))}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+	sut.UseLiveSections(true)
+
+	ctx := context.Background()
+	articles, _, err := sut.FetchTopStories(ctx, nytapi.World)
+
+	require.Nil(t, err)
+	assert.NotNil(t, articles)
+	assert.Equal(t, []string{"/svc/topstories/v2/world.json"}, requestedURLs)
+
+	articles, _, err = sut.FetchTopStories(ctx, "climate")
+
+	require.Nil(t, articles)
+	assert.NotNil(t, err)
+
+	sections, err := sut.TopStoriesSections(ctx)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, nytapi.TopStoriesSections(), sections)
+}
+
+func Test_Client_ShouldDiscoverLiveSections_TopStoriesSections_WithValues(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(sectionListJSON))}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	sections, err := sut.TopStoriesSections(ctx)
+
+	require.Nil(t, err)
+	assert.Equal(t, nytapi.TopStoriesSections(), sections)
+	assert.Equal(t, 0, requests)
+
+	sut.UseLiveSections(true)
+	sections, err = sut.TopStoriesSections(ctx)
+	require.Nil(t, err)
+	sections, err = sut.TopStoriesSections(ctx)
+	require.Nil(t, err)
+
+	assert.Equal(t, []nytapi.TopStoriesSection{nytapi.Arts, nytapi.Tmagazine, nytapi.Us}, sections)
+	assert.Equal(t, 1, requests)
+}
+
+func Test_Client_ShouldFallBackToKnownSectionsWithoutPublishedOnes_TopStoriesSections_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"num_results": 1,
+				"results": [
+					{
+					"section": "climate",
+					"display_name": "Climate"
+					}
+				]
+			}`
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(json))}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+	sut.UseLiveSections(true)
+
+	ctx := context.Background()
+	sections, err := sut.TopStoriesSections(ctx)
+
+	require.Nil(t, err)
+	assert.Equal(t, nytapi.TopStoriesSections(), sections)
+}
+
+func Test_Client_ShouldHandleValid_FetchWireByURL_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 1,
+				"results": [
+					{
+					"section": "World",
+					"title": "England Moves to Lift Its Remaining Virus Restrictions",
+					"url": "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html",
+					"byline": "By Mark Landler",
+					"org_facet": "",
+					"per_facet": ["Johnson, Boris"],
+					"multimedia": [
+						{
+						"url": "https://static01.nyt.com/images/2021/07/05/world/05virus-world/05virus-world-thumbStandard.jpg",
+						"format": "Standard Thumbnail",
+						"type": "image"
+						}
+					]
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	var requestedURL *url.URL
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	article, err := sut.FetchWireByURL(ctx, "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html?smid=url-share#commentsContainer")
+
+	require.Nil(t, err)
+	if assert.NotNil(t, article) {
+		assert.Equal(t, "By Mark Landler", article.Byline)
+		assert.Equal(t, []string{"Johnson, Boris"}, article.PerFacet)
+		assert.Empty(t, article.OrgFacet)
+		assert.Len(t, article.Multimedia, 1)
+	}
+	assert.Equal(t, "/svc/news/v3/content.json", requestedURL.Path)
+	assert.Equal(t, "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html", requestedURL.Query().Get("url"))
+}
+
+func Test_Client_ShouldHandleUnknownURL_FetchWireByURL_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			body := ioutil.NopCloser(strings.NewReader(`{"status": "OK", "num_results": 0, "results": []}`))
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	article, err := sut.FetchWireByURL(ctx, "https://www.nytimes.com/2021/07/05/not-an-article.html")
+
+	require.Nil(t, article)
+	assert.NotNil(t, err)
+}
+
+func Test_Client_ShouldHandleInvalid_FetchWireByURL_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	article, err := sut.FetchWireByURL(ctx, "https://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html")
+
+	require.Nil(t, article)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidURL_FetchWireByURL_withError(t *testing.T) {
+	var cases = []struct {
+		articleURL string
+	}{
+		{""},
+		{"www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html"},
+		{"ftp://www.nytimes.com/2021/07/05/world/europe/england-covid-restrictions.html"},
+		{"https://www.example.com/2021/07/05/world/europe/england-covid-restrictions.html"},
+		{"https://nytimes.com.example.com/2021/07/05/world.html"},
+	}
+
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		article, err := sut.FetchWireByURL(ctx, tt.articleURL)
+
+		require.Nil(t, article)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 0, requests)
+}
+
+const conceptSearchJSON = `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 2,
+				"results": [
+					{
+					"concept_id": 19288,
+					"concept_name": "Obama, Barack",
+					"concept_type": "nytd_per"
+					},
+					{
+					"concept_id": 73251,
+					"concept_name": "Obama Foundation",
+					"concept_type": "nytd_org"
+					}
+				]
+			}`
+
+func Test_Client_ShouldHandleValid_SearchConcepts_WithValues(t *testing.T) {
+	var cases = []struct {
+		conceptType   nytapi.ConceptType
+		expectedNames []string
+	}{
+		{"", []string{"Obama, Barack", "Obama Foundation"}},
+		{nytapi.ConceptTypeOrganization, []string{"Obama Foundation"}},
+		{nytapi.ConceptTypeGeographic, []string{}},
+	}
+
+	for _, tt := range cases {
+		var requestedURL string
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				requestedURL = req.URL.String()
+				return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(conceptSearchJSON))}, nil
+			},
+		}
+		apiKey := "mockedApiKey"
+		sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+		ctx := context.Background()
+		concepts, err := sut.SearchConcepts(ctx, "obama", tt.conceptType)
+
+		require.Nil(t, err)
+		names := []string{}
+		for _, concept := range *concepts {
+			names = append(names, concept.ConceptName)
+		}
+		assert.Equal(t, tt.expectedNames, names)
+		assert.Contains(t, requestedURL, "/semantic/v2/concept/search.json?")
+		assert.Contains(t, requestedURL, "query=obama")
+	}
+}
+
+func Test_Client_ShouldHandleInvalid_SearchConcepts_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	concepts, err := sut.SearchConcepts(ctx, "obama", "")
+
+	require.Nil(t, concepts)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidQueryOrType_SearchConcepts_withError(t *testing.T) {
+	var cases = []struct {
+		searchQuery string
+		conceptType nytapi.ConceptType
+	}{
+		{"", ""},
+		{"obama", "per"},
+	}
+
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		concepts, err := sut.SearchConcepts(ctx, tt.searchQuery, tt.conceptType)
+
+		require.Nil(t, concepts)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 0, requests)
+}
+
+func Test_Client_ShouldHandleValid_FetchConcept_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"copyright": "Copyright (c) 2021 The New York Times Company.  All Rights Reserved.",
+				"num_results": 1,
+				"results": [
+					{
+					"concept_id": 16917,
+					"concept_name": "Paris (France)",
+					"concept_type": "nytd_geo",
+					"geocodes": [
+						{
+						"name": "Paris",
+						"latitude": 48.85341,
+						"longitude": 2.3488
+						}
+					]
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	var requestedURL *url.URL
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	fields := []nytapi.ConceptField{nytapi.ConceptFieldGeocodes, nytapi.ConceptFieldLinks}
+	concept, err := sut.FetchConcept(ctx, nytapi.ConceptTypeGeographic, "Paris (France)", fields)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, concept) {
+		assert.Equal(t, "Paris (France)", concept.ConceptName)
+		assert.Len(t, concept.Geocodes, 1)
+	}
+	assert.Equal(t, "/svc/semantic/v2/concept/name/nytd_geo/Paris (France).json", requestedURL.Path)
+	assert.Equal(t, "geocodes,links", requestedURL.Query().Get("fields"))
+}
+
+func Test_Client_ShouldHandleUnknownConcept_FetchConcept_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			body := ioutil.NopCloser(strings.NewReader(`{"status": "OK", "num_results": 0, "results": []}`))
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	concept, err := sut.FetchConcept(ctx, nytapi.ConceptTypePerson, "Nobody, Really", nil)
+
+	require.Nil(t, concept)
+	assert.NotNil(t, err)
+}
+
+func Test_Client_ShouldHandleInvalid_FetchConcept_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	concept, err := sut.FetchConcept(ctx, nytapi.ConceptTypePerson, "Obama, Barack", nil)
+
+	require.Nil(t, concept)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidParameters_FetchConcept_withError(t *testing.T) {
+	var cases = []struct {
+		conceptType     nytapi.ConceptType
+		specificConcept string
+		fields          []nytapi.ConceptField
+	}{
+		{"per", "Obama, Barack", nil},
+		{nytapi.ConceptTypePerson, "", nil},
+		{nytapi.ConceptTypePerson, "Obama, Barack", []nytapi.ConceptField{nytapi.ConceptFieldLinks, "ancestors"}},
+	}
+
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		concept, err := sut.FetchConcept(ctx, tt.conceptType, tt.specificConcept, tt.fields)
+
+		require.Nil(t, concept)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 0, requests)
+}
+
+func Test_Client_ShouldHandleValid_SuggestTags_WithValues(t *testing.T) {
+	var cases = []struct {
+		filters        []nytapi.TagFilter
+		max            int
+		expectedFilter string
+		expectedMax    string
+	}{
+		{nil, 0, "", ""},
+		{[]nytapi.TagFilter{nytapi.TagFilterPerson}, 5, "(Per)", "5"},
+		{[]nytapi.TagFilter{nytapi.TagFilterPerson, nytapi.TagFilterOrganization}, 10, "(Per),(Org)", "10"},
+	}
+
+	for _, tt := range cases {
+		var requestedURL *url.URL
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				requestedURL = req.URL
+				body := ioutil.NopCloser(strings.NewReader(`["obam", ["Obama, Barack (Per)", "Obama, Michelle (Per)"]]`))
+				return &http.Response{StatusCode: 200, Body: body}, nil
+			},
+		}
+		apiKey := "mockedApiKey"
+		sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+		ctx := context.Background()
+		tags, err := sut.SuggestTags(ctx, "obam", tt.filters, tt.max)
+
+		require.Nil(t, err)
+		if assert.NotNil(t, tags) && assert.Len(t, *tags, 2) {
+			assert.Equal(t, nytapi.TimesTag{Name: "Obama, Barack", Type: "Per"}, (*tags)[0])
+		}
+		assert.Equal(t, "/svc/suggest/v1/timestags.json", requestedURL.Path)
+		assert.Equal(t, "obam", requestedURL.Query().Get("query"))
+		assert.Equal(t, tt.expectedFilter, requestedURL.Query().Get("filter"))
+		assert.Equal(t, tt.expectedMax, requestedURL.Query().Get("max"))
+	}
+}
+
+func Test_Client_ShouldHandleInvalid_SuggestTags_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	tags, err := sut.SuggestTags(ctx, "obam", nil, 0)
+
+	require.Nil(t, tags)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidParameters_SuggestTags_withError(t *testing.T) {
+	var cases = []struct {
+		tagQuery string
+		filters  []nytapi.TagFilter
+		max      int
+	}{
+		{"", nil, 0},
+		{"obam", []nytapi.TagFilter{"per"}, 0},
+		{"obam", nil, -1},
+		{"obam", nil, nytapi.TimesTagsMaxSuggestions + 1},
+	}
+
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		tags, err := sut.SuggestTags(ctx, tt.tagQuery, tt.filters, tt.max)
+
+		require.Nil(t, tags)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 0, requests)
+}
+
+func Test_Client_ShouldHandleValid_FetchComments_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"results": {
+					"comments": [
+						{
+						"commentID": 113245678,
+						"commentSequence": 113245678,
+						"userDisplayName": "Jane",
+						"userLocation": "Brooklyn",
+						"commentBody": "Fascinating read.",
+						"recommendations": 42,
+						"replyCount": 0,
+						"editorsSelection": true
+						}
+					],
+					"totalCommentsFound": 26,
+					"totalParentCommentsFound": 26
+				}
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	var requestedURL *url.URL
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	articleURL := "http://www.nytimes.com/2021/06/24/science/mars-rover.html?smid=tw-share"
+	comments, meta, err := sut.FetchComments(ctx, articleURL, 25, nytapi.CommentSortRecommended)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, comments) && assert.Len(t, *comments, 1) {
+		assert.Equal(t, "Brooklyn", (*comments)[0].UserLocation)
+		assert.True(t, (*comments)[0].EditorsSelection)
+	}
+	if assert.NotNil(t, meta) {
+		assert.Equal(t, 26, meta.TotalParentCommentsFound)
+	}
+	assert.Equal(t, "/svc/community/v3/user-content/url.json", requestedURL.Path)
+	assert.Equal(t, "https://www.nytimes.com/2021/06/24/science/mars-rover.html", requestedURL.Query().Get("url"))
+	assert.Equal(t, "25", requestedURL.Query().Get("offset"))
+	assert.Equal(t, "reader", requestedURL.Query().Get("sort"))
+}
+
+func Test_Client_ShouldHandleInvalid_FetchComments_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	comments, meta, err := sut.FetchComments(ctx, "https://www.nytimes.com/2021/06/24/science/mars-rover.html", 0, "")
+
+	require.Nil(t, comments)
+	require.Nil(t, meta)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidParameters_FetchComments_withError(t *testing.T) {
+	var cases = []struct {
+		articleURL string
+		offset     int
+		sort       nytapi.CommentSort
+	}{
+		{"not a url", 0, ""},
+		{"https://example.com/2021/06/24/science/mars-rover.html", 0, ""},
+		{"https://www.nytimes.com/2021/06/24/science/mars-rover.html", -25, ""},
+		{"https://www.nytimes.com/2021/06/24/science/mars-rover.html", 10, ""},
+		{"https://www.nytimes.com/2021/06/24/science/mars-rover.html", 0, "best"},
+	}
+
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		comments, meta, err := sut.FetchComments(ctx, tt.articleURL, tt.offset, tt.sort)
+
+		require.Nil(t, comments)
+		require.Nil(t, meta)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 0, requests)
+}
+
+func Test_Client_ShouldHandleValid_FetchCommentReplies_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"results": {
+					"comments": [
+						{
+						"commentID": 113245678,
+						"commentSequence": 113245678,
+						"replyCount": 4,
+						"replies": [
+							{
+							"commentID": 113246001,
+							"commentSequence": 113246001,
+							"userDisplayName": "Max",
+							"parentID": 113245678,
+							"depth": 2
+							}
+						]
+						}
+					],
+					"totalReplyCommentsFound": 4
+				}
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	var requestedURL *url.URL
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	replies, meta, err := sut.FetchCommentReplies(ctx, "https://www.nytimes.com/2021/06/24/science/mars-rover.html", 113245678, 3)
+
+	require.Nil(t, err)
+	if assert.NotNil(t, replies) && assert.Len(t, *replies, 1) {
+		assert.Equal(t, "Max", (*replies)[0].UserDisplayName)
+	}
+	if assert.NotNil(t, meta) {
+		assert.Equal(t, 4, meta.TotalReplyCommentsFound)
+	}
+	assert.Equal(t, "/svc/community/v3/user-content/replies.json", requestedURL.Path)
+	assert.Equal(t, "113245678", requestedURL.Query().Get("commentSequence"))
+	assert.Equal(t, "3", requestedURL.Query().Get("offset"))
+}
+
+func Test_Client_ShouldHandleInvalid_FetchCommentReplies_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	replies, meta, err := sut.FetchCommentReplies(ctx, "https://www.nytimes.com/2021/06/24/science/mars-rover.html", 113245678, 0)
+
+	require.Nil(t, replies)
+	require.Nil(t, meta)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidParameters_FetchCommentReplies_withError(t *testing.T) {
+	var cases = []struct {
+		articleURL      string
+		commentSequence int
+		offset          int
+	}{
+		{"https://example.com/2021/06/24/science/mars-rover.html", 113245678, 0},
+		{"https://www.nytimes.com/2021/06/24/science/mars-rover.html", 0, 0},
+		{"https://www.nytimes.com/2021/06/24/science/mars-rover.html", 113245678, -1},
+	}
+
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		replies, meta, err := sut.FetchCommentReplies(ctx, tt.articleURL, tt.commentSequence, tt.offset)
+
+		require.Nil(t, replies)
+		require.Nil(t, meta)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 0, requests)
+}
+
+func Test_Client_ShouldHandleValid_FetchGeo_WithValues(t *testing.T) {
+	json := `{
+				"status": "OK",
+				"results": [
+					{
+					"name": "Paris",
+					"latitude": 48.85341,
+					"longitude": 2.3488,
+					"feature_class": "P",
+					"country_name": "France",
+					"population": 2138551
+					}
+				]
+			}`
+	body := ioutil.NopCloser(bytes.NewReader([]byte(json)))
+
+	var requestedURL *url.URL
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL
+			return &http.Response{StatusCode: 200, Body: body}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	places, err := sut.FetchGeo(ctx, "Paris (France)")
+
+	require.Nil(t, err)
+	if assert.NotNil(t, places) && assert.Len(t, *places, 1) {
+		assert.Equal(t, "France", (*places)[0].CountryName)
+		assert.Equal(t, "2138551", (*places)[0].Population.String())
+	}
+	assert.Equal(t, "/svc/semantic/v2/geocodes/query.json", requestedURL.Path)
+	assert.Equal(t, "Paris (France)", requestedURL.Query().Get("query"))
+}
+
+func Test_Client_ShouldHandleInvalid_FetchGeo_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	places, err := sut.FetchGeo(ctx, "Paris (France)")
+
+	require.Nil(t, places)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidQuery_FetchGeo_withError(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	places, err := sut.FetchGeo(ctx, "")
+
+	require.Nil(t, places)
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, requests)
+}
+
+func Test_Client_ShouldCache_ResolveGeoFacets_WithValues(t *testing.T) {
+	requests := map[string]int{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			facet := req.URL.Query().Get("query")
+			requests[facet]++
+			json := `{"status": "OK", "results": []}`
+			if facet == "Brooklyn (NYC)" {
+				json = `{"status": "OK", "results": [{"name": "Brooklyn", "latitude": 40.6501, "longitude": -73.94958, "country_code": "US"}]}`
+			}
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(json))}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		places, err := sut.ResolveGeoFacets(ctx, []string{"Brooklyn (NYC)", "Atlantis", "Brooklyn (NYC)", ""})
+
+		require.Nil(t, err)
+		if assert.Len(t, places, 1) {
+			assert.Equal(t, "Brooklyn", places["Brooklyn (NYC)"].Name)
+			assert.Equal(t, "40.6501", places["Brooklyn (NYC)"].Latitude.String())
+		}
+	}
+	assert.Equal(t, map[string]int{"Brooklyn (NYC)": 1, "Atlantis": 1}, requests)
+}
+
+func Test_Client_ShouldNotCacheFailures_ResolveGeoFacets_WithError(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			if requests == 1 {
+				return &http.Response{StatusCode: 429}, nil
+			}
+			json := `{"status": "OK", "results": [{"name": "Brooklyn"}]}`
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(json))}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	places, err := sut.ResolveGeoFacets(ctx, []string{"Brooklyn (NYC)"})
+
+	require.Nil(t, places)
+	assert.IsType(t, apierror.APIError{}, err)
+
+	places, err = sut.ResolveGeoFacets(ctx, []string{"Brooklyn (NYC)"})
+
+	require.Nil(t, err)
+	assert.Len(t, places, 1)
+	assert.Equal(t, 2, requests)
+}
+
+func Test_Client_ShouldHandleValid_FetchMostShared_WithValues(t *testing.T) {
+	var cases = []struct {
+		period       nytapi.MostPopularPeriod
+		shareType    nytapi.MostPopularShareType
+		expectedPath string
+	}{
+		{nytapi.Week, nytapi.Facebook, "/svc/mostpopular/v2/shared/7/facebook.json"},
+		{nytapi.Day, "", "/svc/mostpopular/v2/shared/1.json"},
+	}
+
+	for _, tt := range cases {
+		var requestedURL *url.URL
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				requestedURL = req.URL
+				body := ioutil.NopCloser(strings.NewReader(`{"status": "OK", "num_results": 1, "results": [{"title": "The Best Type of Exercise? A Blood Test Holds Clues"}]}`))
+				return &http.Response{StatusCode: 200, Body: body}, nil
+			},
+		}
+		apiKey := "mockedApiKey"
+		sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+		ctx := context.Background()
+		articles, err := sut.FetchMostShared(ctx, tt.period, tt.shareType)
+
+		require.Nil(t, err)
+		if assert.NotNil(t, articles) && assert.Len(t, *articles, 1) {
+			assert.Equal(t, "The Best Type of Exercise? A Blood Test Holds Clues", (*articles)[0].Title)
+		}
+		assert.Equal(t, tt.expectedPath, requestedURL.Path)
+	}
+}
+
+func Test_Client_ShouldHandleInvalid_FetchMostShared_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	articles, err := sut.FetchMostShared(ctx, nytapi.Day, nytapi.Facebook)
+
+	require.Nil(t, articles)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidParameters_FetchMostShared_withError(t *testing.T) {
+	var cases = []struct {
+		period    nytapi.MostPopularPeriod
+		shareType nytapi.MostPopularShareType
+	}{
+		{nytapi.MostPopularPeriod(100), nytapi.Facebook},
+		{nytapi.MostPopularPeriod(100), ""},
+		{nytapi.Day, "myspace"},
+	}
+
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		articles, err := sut.FetchMostShared(ctx, tt.period, tt.shareType)
+
+		require.Nil(t, articles)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 0, requests)
+}
+
+func Test_Client_ShouldHandleValid_DoRaw_WithValues(t *testing.T) {
+	var cases = []struct {
+		rawPath        string
+		params         url.Values
+		expectedPath   string
+		expectedParams url.Values
+	}{
+		{"books/v3/lists/names.json", nil, "/svc/books/v3/lists/names.json", url.Values{}},
+		{"svcs/v1/test.json", nil, "/svc/svcs/v1/test.json", url.Values{}},
+		{"/svc/books/v3/lists/names.json", url.Values{"offset": {"20"}}, "/svc/books/v3/lists/names.json", url.Values{"offset": {"20"}}},
+		{"https://api.nytimes.com/svc/news/v3/content/all/all.json?limit=5&offset=20", url.Values{"limit": {"10"}}, "/svc/news/v3/content/all/all.json", url.Values{"limit": {"10"}, "offset": {"20"}}},
+	}
+
+	for _, tt := range cases {
+		var requestedURL *url.URL
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				requestedURL = req.URL
+				header := http.Header{"Content-Type": {"application/json"}}
+				return &http.Response{StatusCode: 200, Header: header, Body: ioutil.NopCloser(strings.NewReader(`{"status": "OK"}`))}, nil
+			},
+		}
+		apiKey := "mockedApiKey"
+		sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+		ctx := context.Background()
+		rawResponse, err := sut.DoRaw(ctx, tt.rawPath, tt.params)
+
+		require.Nil(t, err)
+		if assert.NotNil(t, rawResponse) {
+			assert.Equal(t, 200, rawResponse.StatusCode)
+			assert.Equal(t, "application/json", rawResponse.Header.Get("Content-Type"))
+			assert.Equal(t, `{"status": "OK"}`, string(rawResponse.Body))
+		}
+		assert.Equal(t, tt.expectedPath, requestedURL.Path)
+		tt.expectedParams.Set("api-key", apiKey)
+		assert.Equal(t, tt.expectedParams, requestedURL.Query())
+	}
+}
+
+func Test_Client_ShouldHandleInvalid_DoRaw_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	rawResponse, err := sut.DoRaw(ctx, "books/v3/lists/not-a-list.json", nil)
+
+	require.Nil(t, rawResponse)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+}
+
+func Test_Client_ShouldHandleInvalidPath_DoRaw_withError(t *testing.T) {
+	var cases = []struct {
+		rawPath string
+	}{
+		{""},
+		{"/"},
+		{"/svc"},
+		{"/svc/"},
+		{"http://api.nytimes.com/svc/books/v3/lists/names.json"},
+		{"https://example.com/svc/books/v3/lists/names.json"},
+	}
+
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		rawResponse, err := sut.DoRaw(ctx, tt.rawPath, nil)
+
+		require.Nil(t, rawResponse)
+		assert.NotNil(t, err, tt.rawPath)
+	}
+	assert.Equal(t, 0, requests)
+}
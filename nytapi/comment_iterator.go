@@ -0,0 +1,147 @@
+package nytapi
+
+import "context"
+
+// CommentIterator walks all top level comments on an article lazily, page by page.
+//
+// The 'Community' API only embeds the first few replies of a comment, so before a comment is handed out
+// all of its missing replies are fetched, recursively, assembling the full reply tree. Typical usage:
+//
+//	it := client.IterateComments(ctx, articleURL, nytapi.CommentSortNewest)
+//	for it.Next() {
+//		fmt.Println(it.Comment().CommentBody, len(it.Comment().Replies))
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type CommentIterator struct {
+	ctx        context.Context
+	client     *Client
+	articleURL string
+	sort       CommentSort
+	offset     int
+	done       bool
+	buffer     []Comment
+	current    Comment
+	err        error
+}
+
+// IterateComments provides an iterator over all comments on an article including their complete reply trees.
+// An empty sort leaves the order to the API.
+func (c *Client) IterateComments(ctx context.Context, articleURL string, sort CommentSort) *CommentIterator {
+	it := &CommentIterator{
+		ctx:    ctx,
+		client: c,
+		sort:   sort,
+	}
+	normalizedURL, err := normalizeArticleURL(articleURL)
+	if err != nil {
+		it.err = err
+	}
+	it.articleURL = normalizedURL
+	if sort != "" {
+		if err := sort.IsValid(); err != nil {
+			it.err = err
+		}
+	}
+	return it
+}
+
+// Next advances the iterator to the next top level comment, fetching further pages and replies as needed.
+// It returns false once all comments have been visited or an error occurred.
+func (it *CommentIterator) Next() bool {
+	for {
+		if it.err != nil {
+			return false
+		}
+
+		if len(it.buffer) > 0 {
+			comment := it.buffer[0]
+			it.buffer = it.buffer[1:]
+			if err := it.completeReplies(&comment); err != nil {
+				it.err = err
+				return false
+			}
+			it.current = comment
+			return true
+		}
+
+		if it.done {
+			return false
+		}
+
+		if err := it.fetchPage(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+}
+
+// Comment returns the comment the iterator currently points to.
+func (it *CommentIterator) Comment() Comment {
+	return it.current
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *CommentIterator) Err() error {
+	return it.err
+}
+
+func (it *CommentIterator) fetchPage() error {
+	if err := it.ctx.Err(); err != nil {
+		return err
+	}
+
+	comments, meta, err := it.client.FetchComments(it.ctx, it.articleURL, it.offset, it.sort)
+	if err != nil {
+		return err
+	}
+
+	it.buffer = *comments
+	it.offset += CommentsPageSize
+	if len(*comments) == 0 || it.offset >= meta.TotalParentCommentsFound {
+		it.done = true
+	}
+	return nil
+}
+
+// Fetches the replies of a comment which were not embedded yet, then does the same for every reply.
+func (it *CommentIterator) completeReplies(comment *Comment) error {
+	if len(comment.Replies) < comment.ReplyCount {
+		seen := map[int]bool{}
+		for _, reply := range comment.Replies {
+			seen[reply.CommentID] = true
+		}
+
+		for len(comment.Replies) < comment.ReplyCount {
+			if err := it.ctx.Err(); err != nil {
+				return err
+			}
+
+			replies, _, err := it.client.FetchCommentReplies(it.ctx, it.articleURL, comment.CommentSequence, len(comment.Replies))
+			if err != nil {
+				return err
+			}
+
+			added := 0
+			for _, reply := range *replies {
+				if !seen[reply.CommentID] {
+					seen[reply.CommentID] = true
+					comment.Replies = append(comment.Replies, reply)
+					added++
+				}
+			}
+			// Replies may have been removed since the count was taken, which must not loop forever.
+			if added == 0 {
+				break
+			}
+		}
+	}
+
+	for i := range comment.Replies {
+		if err := it.completeReplies(&comment.Replies[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package nytapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+const mockedCommentsArticleURL = "https://www.nytimes.com/2021/06/24/science/mars-rover.html"
+
+// Number of replies the mocked 'Community' API embeds into a comment, as well as delivers per page of replies.
+const mockedCommentsEmbeddedReplies = 3
+
+// Builds a comment with the given number of replies, each of which holds the given number of replies in turn.
+func newMockedComment(sequence int, replies int, nestedReplies int) nytapi.Comment {
+	comment := nytapi.Comment{CommentID: sequence, CommentSequence: sequence, ReplyCount: replies}
+	for i := 1; i <= replies; i++ {
+		reply := nytapi.Comment{CommentID: sequence*100 + i, CommentSequence: sequence*100 + i, ParentID: sequence, ReplyCount: nestedReplies}
+		for j := 1; j <= nestedReplies; j++ {
+			nested := nytapi.Comment{CommentID: reply.CommentID*100 + j, CommentSequence: reply.CommentID*100 + j, ParentID: reply.CommentID}
+			reply.Replies = append(reply.Replies, nested)
+		}
+		comment.Replies = append(comment.Replies, reply)
+	}
+	return comment
+}
+
+// Removes all but the first replies throughout the tree, as the 'Community' API does.
+func truncateMockedReplies(comment nytapi.Comment) nytapi.Comment {
+	if len(comment.Replies) > mockedCommentsEmbeddedReplies {
+		comment.Replies = comment.Replies[:mockedCommentsEmbeddedReplies]
+	}
+	replies := make([]nytapi.Comment, len(comment.Replies))
+	for i, reply := range comment.Replies {
+		replies[i] = truncateMockedReplies(reply)
+	}
+	comment.Replies = replies
+	return comment
+}
+
+func findMockedComment(comments []nytapi.Comment, sequence int) *nytapi.Comment {
+	for i := range comments {
+		if comments[i].CommentSequence == sequence {
+			return &comments[i]
+		}
+		if found := findMockedComment(comments[i].Replies, sequence); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// Provides an HTTP client mimicking the 'Community' API on top of the given comments,
+// including paging of top level comments and of replies.
+func newMockedCommunityHTTPClient(comments []nytapi.Comment, requests *int) *port.MockedHTTPClient {
+	return &port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			*requests++
+			params := req.URL.Query()
+			offset, _ := strconv.Atoi(params.Get("offset"))
+
+			page := []nytapi.Comment{}
+			if strings.HasSuffix(req.URL.Path, "/replies.json") {
+				sequence, _ := strconv.Atoi(params.Get("commentSequence"))
+				parent := findMockedComment(comments, sequence)
+				if parent == nil {
+					return &http.Response{StatusCode: 404}, nil
+				}
+				replies := []nytapi.Comment{}
+				for i := offset; i < len(parent.Replies) && i < offset+mockedCommentsEmbeddedReplies; i++ {
+					replies = append(replies, truncateMockedReplies(parent.Replies[i]))
+				}
+				page = append(page, nytapi.Comment{CommentID: parent.CommentID, CommentSequence: sequence, ReplyCount: parent.ReplyCount, Replies: replies})
+			} else {
+				for i := offset; i < len(comments) && i < offset+nytapi.CommentsPageSize; i++ {
+					page = append(page, truncateMockedReplies(comments[i]))
+				}
+			}
+
+			body, _ := json.Marshal(map[string]interface{}{
+				"status": "OK",
+				"results": map[string]interface{}{
+					"comments":                 page,
+					"totalParentCommentsFound": len(comments),
+				},
+			})
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(body))}, nil
+		},
+	}
+}
+
+func Test_CommentIterator_ShouldAssembleReplyTree_WithValues(t *testing.T) {
+	comments := []nytapi.Comment{}
+	for i := 1; i <= 30; i++ {
+		comments = append(comments, newMockedComment(i, 0, 0))
+	}
+	comments[0] = newMockedComment(1, 2, 0)
+	comments[1] = newMockedComment(2, 7, 0)
+	comments[27] = newMockedComment(28, 4, 5)
+
+	requests := 0
+	sut := nytapi.NewClient(newMockedCommunityHTTPClient(comments, &requests), "mockedApiKey")
+
+	ctx := context.Background()
+	it := sut.IterateComments(ctx, mockedCommentsArticleURL, nytapi.CommentSortNewest)
+	visited := []nytapi.Comment{}
+	for it.Next() {
+		visited = append(visited, it.Comment())
+	}
+
+	require.Nil(t, it.Err())
+	assert.Equal(t, comments, visited)
+	// Two pages of comments, two more pages of replies to comment 2, one to comment 28 and one to each of its four replies.
+	assert.Equal(t, 2+2+1+4, requests)
+}
+
+func Test_CommentIterator_ShouldHonourCancellation_WithError(t *testing.T) {
+	comments := []nytapi.Comment{}
+	for i := 1; i <= 30; i++ {
+		comments = append(comments, newMockedComment(i, 0, 0))
+	}
+
+	requests := 0
+	sut := nytapi.NewClient(newMockedCommunityHTTPClient(comments, &requests), "mockedApiKey")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := sut.IterateComments(ctx, mockedCommentsArticleURL, "")
+
+	require.True(t, it.Next())
+	cancel()
+	visited := 1
+	for it.Next() {
+		visited++
+	}
+
+	assert.Equal(t, nytapi.CommentsPageSize, visited)
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, context.Canceled, it.Err())
+}
+
+func Test_CommentIterator_ShouldHandleInvalidParameters_WithError(t *testing.T) {
+	var cases = []struct {
+		articleURL string
+		sort       nytapi.CommentSort
+	}{
+		{"https://example.com/2021/06/24/science/mars-rover.html", ""},
+		{mockedCommentsArticleURL, "best"},
+	}
+
+	for _, tt := range cases {
+		requests := 0
+		sut := nytapi.NewClient(newMockedCommunityHTTPClient(nil, &requests), "mockedApiKey")
+
+		ctx := context.Background()
+		it := sut.IterateComments(ctx, tt.articleURL, tt.sort)
+
+		assert.False(t, it.Next())
+		assert.Error(t, it.Err())
+		assert.Equal(t, 0, requests)
+	}
+}
+
+func Test_CommentIterator_ShouldHandleFailureResponse_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 429}, nil
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "mockedApiKey")
+
+	ctx := context.Background()
+	it := sut.IterateComments(ctx, mockedCommentsArticleURL, "")
+
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
@@ -0,0 +1,32 @@
+package nytapi
+
+import "fmt"
+
+// CommentSort defined by the New York Times API as either: newest, oldest, reader
+type CommentSort string
+
+// Valid 'Community' sort order as defined by the New York Times API.
+const (
+	CommentSortNewest      CommentSort = "newest"
+	CommentSortOldest      CommentSort = "oldest"
+	CommentSortRecommended CommentSort = "reader"
+)
+
+// CommentsPageSize is the number of top level comments the 'Community' API delivers per page.
+const CommentsPageSize = 25
+
+// IsValid checks the validity of a 'Community' sort order.
+func (sort CommentSort) IsValid() error {
+	switch sort {
+	case CommentSortNewest, CommentSortOldest, CommentSortRecommended:
+		return nil
+	}
+	return fmt.Errorf("invalid comment sort: %v", sort)
+}
+
+func isValidCommentOffset(offset int) error {
+	if offset < 0 || offset%CommentsPageSize != 0 {
+		return fmt.Errorf("invalid comment offset: %v", offset)
+	}
+	return nil
+}
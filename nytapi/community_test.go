@@ -0,0 +1,37 @@
+package nytapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+func Test_CommentSort_ShouldBeValid(t *testing.T) {
+	var cases = []struct {
+		sort nytapi.CommentSort
+	}{
+		{nytapi.CommentSortNewest},
+		{nytapi.CommentSortOldest},
+		{nytapi.CommentSortRecommended},
+		{"reader"},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.sort.IsValid())
+	}
+}
+
+func Test_CommentSort_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		sort nytapi.CommentSort
+	}{
+		{""},
+		{"recommended"},
+		{"NEWEST"},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.sort.IsValid())
+	}
+}
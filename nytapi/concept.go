@@ -0,0 +1,50 @@
+package nytapi
+
+import "fmt"
+
+// ConceptType defined by the New York Times API as either: nytd_des, nytd_geo, nytd_org, nytd_per
+type ConceptType string
+
+// Valid 'Semantic' concept type as defined by the New York Times API.
+const (
+	ConceptTypeDescriptor   ConceptType = "nytd_des"
+	ConceptTypeGeographic   ConceptType = "nytd_geo"
+	ConceptTypeOrganization ConceptType = "nytd_org"
+	ConceptTypePerson       ConceptType = "nytd_per"
+)
+
+// IsValid checks the validity of a 'Semantic' concept type.
+func (conceptType ConceptType) IsValid() error {
+	switch conceptType {
+	case ConceptTypeDescriptor, ConceptTypeGeographic, ConceptTypeOrganization, ConceptTypePerson:
+		return nil
+	}
+	return fmt.Errorf("invalid concept type: %v", conceptType)
+}
+
+// ConceptField defined by the New York Times API, requesting additional information on a concept.
+type ConceptField string
+
+// Valid 'Semantic' concept field as defined by the New York Times API.
+const (
+	ConceptFieldAll            ConceptField = "all"
+	ConceptFieldPages          ConceptField = "pages"
+	ConceptFieldTickerSymbol   ConceptField = "ticker_symbol"
+	ConceptFieldLinks          ConceptField = "links"
+	ConceptFieldTaxonomy       ConceptField = "taxonomy"
+	ConceptFieldCombinations   ConceptField = "combinations"
+	ConceptFieldGeocodes       ConceptField = "geocodes"
+	ConceptFieldArticleList    ConceptField = "article_list"
+	ConceptFieldScopeNotes     ConceptField = "scope_notes"
+	ConceptFieldSearchAPIQuery ConceptField = "search_api_query"
+)
+
+// IsValid checks the validity of a 'Semantic' concept field.
+func (field ConceptField) IsValid() error {
+	switch field {
+	case ConceptFieldAll, ConceptFieldPages, ConceptFieldTickerSymbol, ConceptFieldLinks, ConceptFieldTaxonomy,
+		ConceptFieldCombinations, ConceptFieldGeocodes, ConceptFieldArticleList, ConceptFieldScopeNotes, ConceptFieldSearchAPIQuery:
+		return nil
+	}
+	return fmt.Errorf("invalid concept field: %v", field)
+}
@@ -0,0 +1,272 @@
+package nytapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ConceptGraphDefaultInterval is the pause between two requests of a crawl unless configured otherwise,
+// keeping the crawl within the DefaultRequestsPerMinute of an API key.
+const ConceptGraphDefaultInterval = time.Minute / DefaultRequestsPerMinute
+
+// ConceptGraphDefaultMaxConcepts is the number of concepts fetched during a crawl unless configured otherwise.
+const ConceptGraphDefaultMaxConcepts = 50
+
+// Relations between the nodes of a concept graph. External links and taxonomic relations keep the relation
+// delivered by the API, e.g. sameAs, falling back to ConceptRelationTaxonomy if the API names none.
+const (
+	ConceptRelationDescendant = "descendant"
+	ConceptRelationLink       = "link"
+	ConceptRelationTaxonomy   = "taxonomy"
+)
+
+// Fields requested for every concept of a crawl, delivering its external links and taxonomic relations.
+var conceptGraphFields = []ConceptField{ConceptFieldLinks, ConceptFieldTaxonomy}
+
+// ConceptGraphQuery describes where a crawl of the concept graph starts and how far it reaches.
+// A depth of 0 only fetches the start concept, zero values of the other bounds fall back to their defaults.
+// The interval is the pause between two requests of a client without rate limit. A client limited via WithRateLimit
+// paces the requests by its rate limit alone, ignoring the interval.
+type ConceptGraphQuery struct {
+	ConceptType     ConceptType
+	SpecificConcept string
+	MaxDepth        int
+	MaxConcepts     int
+	Interval        time.Duration
+}
+
+// IsValid checks the validity of a concept graph query.
+func (q ConceptGraphQuery) IsValid() error {
+	if err := q.ConceptType.IsValid(); err != nil {
+		return err
+	}
+	if q.SpecificConcept == "" {
+		return fmt.Errorf("invalid concept graph query: start concept must not be empty")
+	}
+	if q.MaxDepth < 0 || q.MaxConcepts < 0 || q.Interval < 0 {
+		return fmt.Errorf("invalid concept graph query: bounds must not be negative")
+	}
+	return nil
+}
+
+// ConceptGraph of concepts and external links connected by their relations.
+type ConceptGraph struct {
+	Root  string        `json:"root"`
+	Nodes []ConceptNode `json:"nodes"`
+	Edges []ConceptEdge `json:"edges"`
+}
+
+// ConceptNode is either a concept, identified by type and name, or an external link identified by its URL.
+// Expanded reports whether the relations of a concept have been fetched.
+type ConceptNode struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Depth    int    `json:"depth"`
+	Expanded bool   `json:"expanded"`
+}
+
+// ConceptEdge connects two nodes of a concept graph. Ancestry is always directed from ancestor to descendant.
+type ConceptEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Relation string `json:"relation"`
+}
+
+// WriteDOT writes the concept graph in the DOT language of Graphviz.
+func (g *ConceptGraph) WriteDOT(w io.Writer) error {
+	var builder strings.Builder
+	builder.WriteString("digraph concepts {\n")
+	for _, node := range g.Nodes {
+		shape := "ellipse"
+		if node.Type == ConceptRelationLink {
+			shape = "box"
+		}
+		fmt.Fprintf(&builder, "\t%q [label=%q, shape=%v];\n", node.ID, node.Name, shape)
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&builder, "\t%q -> %q [label=%q];\n", edge.From, edge.To, edge.Relation)
+	}
+	builder.WriteString("}\n")
+
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
+
+// CrawlConceptGraph walks the relations of a concept breadth first, following ancestors, descendants and
+// taxonomic relations up to the given depth and attaching the external links of every fetched concept.
+//
+// Concepts are fetched one at a time, paced by the rate limit of the client or otherwise by the interval of the
+// query, requests failing with too many requests are
+// retried according to the RetryPolicy of the client. Related concepts which cannot be fetched remain unexpanded,
+// only a failure on the start concept or the context ends the crawl with an error.
+func (c *Client) CrawlConceptGraph(ctx context.Context, graphQuery ConceptGraphQuery) (*ConceptGraph, error) {
+	if err := graphQuery.IsValid(); err != nil {
+		return nil, err
+	}
+	if graphQuery.MaxConcepts == 0 {
+		graphQuery.MaxConcepts = ConceptGraphDefaultMaxConcepts
+	}
+	if c.rateLimit.RequestsPerMinute > 0 {
+		graphQuery.Interval = 0
+	} else if graphQuery.Interval == 0 {
+		graphQuery.Interval = ConceptGraphDefaultInterval
+	}
+
+	crawler := conceptGraphCrawler{
+		client: c,
+		query:  graphQuery,
+		graph:  &ConceptGraph{},
+		nodes:  map[string]int{},
+		edges:  map[ConceptEdge]bool{},
+		queued: map[string]bool{},
+	}
+	return crawler.crawl(ctx)
+}
+
+type conceptGraphCrawler struct {
+	client   *Client
+	query    ConceptGraphQuery
+	graph    *ConceptGraph
+	nodes    map[string]int
+	edges    map[ConceptEdge]bool
+	queued   map[string]bool
+	requests int
+}
+
+type conceptGraphVisit struct {
+	conceptType string
+	name        string
+	depth       int
+}
+
+func (crawler *conceptGraphCrawler) crawl(ctx context.Context) (*ConceptGraph, error) {
+	root := conceptGraphVisit{conceptType: string(crawler.query.ConceptType), name: crawler.query.SpecificConcept}
+	crawler.graph.Root = crawler.addConcept(root.conceptType, root.name, 0)
+	crawler.queued[crawler.graph.Root] = true
+
+	queue := []conceptGraphVisit{root}
+	fetched := 0
+	for len(queue) > 0 && fetched < crawler.query.MaxConcepts {
+		visit := queue[0]
+		queue = queue[1:]
+
+		concept, err := crawler.fetch(ctx, visit)
+		if err != nil {
+			if visit == root || ctx.Err() != nil {
+				return crawler.graph, err
+			}
+			continue
+		}
+		fetched++
+
+		id := conceptNodeID(visit.conceptType, visit.name)
+		crawler.graph.Nodes[crawler.nodes[id]].Expanded = true
+
+		for _, link := range concept.Links {
+			crawler.addNode(ConceptNode{ID: link.Link, Name: link.Link, Type: ConceptRelationLink, Depth: visit.depth + 1})
+			crawler.addEdge(ConceptEdge{From: id, To: link.Link, Relation: link.Relation})
+		}
+
+		related := make([]conceptGraphVisit, 0, len(concept.Ancestors)+len(concept.Descendants)+len(concept.Taxonomy))
+		for _, ancestor := range concept.Ancestors {
+			ancestorID := crawler.addConcept(ancestor.ConceptType, ancestor.ConceptName, visit.depth+1)
+			crawler.addEdge(ConceptEdge{From: ancestorID, To: id, Relation: ConceptRelationDescendant})
+			related = append(related, conceptGraphVisit{ancestor.ConceptType, ancestor.ConceptName, visit.depth + 1})
+		}
+		for _, descendant := range concept.Descendants {
+			descendantID := crawler.addConcept(descendant.ConceptType, descendant.ConceptName, visit.depth+1)
+			crawler.addEdge(ConceptEdge{From: id, To: descendantID, Relation: ConceptRelationDescendant})
+			related = append(related, conceptGraphVisit{descendant.ConceptType, descendant.ConceptName, visit.depth + 1})
+		}
+		for _, taxonomy := range concept.Taxonomy {
+			source := conceptGraphVisit{taxonomy.SourceConceptType, taxonomy.SourceConceptName, visit.depth + 1}
+			target := conceptGraphVisit{taxonomy.TargetConceptType, taxonomy.TargetConceptName, visit.depth + 1}
+			if source.name == "" || target.name == "" {
+				continue
+			}
+			relation := taxonomy.TaxonomicRelation
+			if relation == "" {
+				relation = ConceptRelationTaxonomy
+			}
+			sourceID := crawler.addConcept(source.conceptType, source.name, source.depth)
+			targetID := crawler.addConcept(target.conceptType, target.name, target.depth)
+			crawler.addEdge(ConceptEdge{From: sourceID, To: targetID, Relation: relation})
+			if sourceID != id {
+				related = append(related, source)
+			}
+			if targetID != id {
+				related = append(related, target)
+			}
+		}
+
+		if visit.depth >= crawler.query.MaxDepth {
+			continue
+		}
+		for _, next := range related {
+			nextID := conceptNodeID(next.conceptType, next.name)
+			if !crawler.queued[nextID] {
+				crawler.queued[nextID] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return crawler.graph, nil
+}
+
+// Fetches a concept, pausing before every request but the first unless paced by the rate limit of the client.
+func (crawler *conceptGraphCrawler) fetch(ctx context.Context, visit conceptGraphVisit) (*Concept, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if crawler.requests > 0 && crawler.query.Interval > 0 {
+		if err := crawler.wait(ctx, crawler.query.Interval); err != nil {
+			return nil, err
+		}
+	}
+	crawler.requests++
+
+	return crawler.client.FetchConcept(ctx, ConceptType(visit.conceptType), visit.name, conceptGraphFields)
+}
+
+func (crawler *conceptGraphCrawler) wait(ctx context.Context, pause time.Duration) error {
+	timer := time.NewTimer(pause)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (crawler *conceptGraphCrawler) addConcept(conceptType string, name string, depth int) string {
+	id := conceptNodeID(conceptType, name)
+	crawler.addNode(ConceptNode{ID: id, Name: name, Type: conceptType, Depth: depth})
+	return id
+}
+
+func (crawler *conceptGraphCrawler) addNode(node ConceptNode) {
+	if _, ok := crawler.nodes[node.ID]; ok {
+		return
+	}
+	crawler.nodes[node.ID] = len(crawler.graph.Nodes)
+	crawler.graph.Nodes = append(crawler.graph.Nodes, node)
+}
+
+func (crawler *conceptGraphCrawler) addEdge(edge ConceptEdge) {
+	if crawler.edges[edge] {
+		return
+	}
+	crawler.edges[edge] = true
+	crawler.graph.Edges = append(crawler.graph.Edges, edge)
+}
+
+func conceptNodeID(conceptType string, name string) string {
+	return conceptType + "/" + name
+}
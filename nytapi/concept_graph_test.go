@@ -0,0 +1,358 @@
+package nytapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+// Provides an HTTP client mimicking the 'Semantic' API on top of the given concepts, keyed by name.
+// Unknown concepts are answered with 404, the first request for a name listed in throttled with 429.
+func newMockedSemanticHTTPClient(concepts map[string]map[string]interface{}, throttled map[string]bool, requests *[]string) *port.MockedHTTPClient {
+	return &port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			name := strings.TrimSuffix(req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:], ".json")
+			*requests = append(*requests, name)
+
+			if throttled[name] {
+				throttled[name] = false
+				return &http.Response{StatusCode: 429}, nil
+			}
+			concept, ok := concepts[name]
+			if !ok {
+				return &http.Response{StatusCode: 404}, nil
+			}
+
+			body, _ := json.Marshal(map[string]interface{}{
+				"status":      "OK",
+				"num_results": 1,
+				"results":     []interface{}{concept},
+			})
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(body))}, nil
+		},
+	}
+}
+
+func newMockedConcept(name string, ancestors []string, descendants []string, links []string) map[string]interface{} {
+	relations := func(names []string) []map[string]string {
+		related := []map[string]string{}
+		for _, name := range names {
+			related = append(related, map[string]string{"concept_name": name, "concept_type": "nytd_des"})
+		}
+		return related
+	}
+	conceptLinks := []map[string]string{}
+	for _, link := range links {
+		conceptLinks = append(conceptLinks, map[string]string{"link": link, "relation": "sameAs", "link_type": "wikipedia_raw_name"})
+	}
+
+	return map[string]interface{}{
+		"concept_name": name,
+		"concept_type": "nytd_des",
+		"ancestors":    relations(ancestors),
+		"descendants":  relations(descendants),
+		"links":        conceptLinks,
+	}
+}
+
+// Sports has the descendants Baseball and Soccer, Baseball in turn has the descendant Little League.
+var mockedConcepts = map[string]map[string]interface{}{
+	"Sports":        newMockedConcept("Sports", nil, []string{"Baseball", "Soccer"}, []string{"http://en.wikipedia.org/wiki/Sport"}),
+	"Baseball":      newMockedConcept("Baseball", []string{"Sports"}, []string{"Little League"}, nil),
+	"Soccer":        newMockedConcept("Soccer", []string{"Sports"}, nil, nil),
+	"Little League": newMockedConcept("Little League", []string{"Baseball"}, nil, nil),
+}
+
+func Test_Client_ShouldCrawlConceptGraph_WithValues(t *testing.T) {
+	var cases = []struct {
+		maxDepth         int
+		expectedRequests []string
+		expectedNodes    int
+		expectedEdges    int
+	}{
+		{0, []string{"Sports"}, 4, 3},
+		{1, []string{"Sports", "Baseball", "Soccer"}, 5, 4},
+		{2, []string{"Sports", "Baseball", "Soccer", "Little League"}, 5, 4},
+		{5, []string{"Sports", "Baseball", "Soccer", "Little League"}, 5, 4},
+	}
+
+	for _, tt := range cases {
+		requests := []string{}
+		sut := nytapi.NewClient(newMockedSemanticHTTPClient(mockedConcepts, nil, &requests), "mockedApiKey")
+
+		ctx := context.Background()
+		graphQuery := nytapi.ConceptGraphQuery{
+			ConceptType:     nytapi.ConceptTypeDescriptor,
+			SpecificConcept: "Sports",
+			MaxDepth:        tt.maxDepth,
+			Interval:        time.Nanosecond,
+		}
+		graph, err := sut.CrawlConceptGraph(ctx, graphQuery)
+
+		require.Nil(t, err)
+		assert.Equal(t, tt.expectedRequests, requests)
+		assert.Equal(t, "nytd_des/Sports", graph.Root)
+		assert.Len(t, graph.Nodes, tt.expectedNodes)
+		assert.Len(t, graph.Edges, tt.expectedEdges)
+	}
+}
+
+func Test_Client_ShouldDeduplicateConceptGraph_WithValues(t *testing.T) {
+	requests := []string{}
+	sut := nytapi.NewClient(newMockedSemanticHTTPClient(mockedConcepts, nil, &requests), "mockedApiKey")
+
+	ctx := context.Background()
+	graphQuery := nytapi.ConceptGraphQuery{
+		ConceptType:     nytapi.ConceptTypeDescriptor,
+		SpecificConcept: "Baseball",
+		MaxDepth:        3,
+		Interval:        time.Nanosecond,
+	}
+	graph, err := sut.CrawlConceptGraph(ctx, graphQuery)
+
+	require.Nil(t, err)
+	assert.Equal(t, []string{"Baseball", "Sports", "Little League", "Soccer"}, requests)
+	assert.Contains(t, graph.Edges, nytapi.ConceptEdge{From: "nytd_des/Sports", To: "nytd_des/Baseball", Relation: nytapi.ConceptRelationDescendant})
+	assert.Contains(t, graph.Edges, nytapi.ConceptEdge{From: "nytd_des/Sports", To: "http://en.wikipedia.org/wiki/Sport", Relation: "sameAs"})
+	assert.Len(t, graph.Edges, 4)
+	for _, node := range graph.Nodes {
+		if node.Type != nytapi.ConceptRelationLink {
+			assert.True(t, node.Expanded, node.ID)
+		}
+	}
+}
+
+func Test_Client_ShouldBoundConceptGraphByConcepts_WithValues(t *testing.T) {
+	requests := []string{}
+	sut := nytapi.NewClient(newMockedSemanticHTTPClient(mockedConcepts, nil, &requests), "mockedApiKey")
+
+	ctx := context.Background()
+	graphQuery := nytapi.ConceptGraphQuery{
+		ConceptType:     nytapi.ConceptTypeDescriptor,
+		SpecificConcept: "Sports",
+		MaxDepth:        5,
+		MaxConcepts:     2,
+		Interval:        time.Nanosecond,
+	}
+	graph, err := sut.CrawlConceptGraph(ctx, graphQuery)
+
+	require.Nil(t, err)
+	assert.Equal(t, []string{"Sports", "Baseball"}, requests)
+	assert.Len(t, graph.Nodes, 5)
+}
+
+func Test_Client_ShouldRetryThrottledConcepts_CrawlConceptGraph_WithValues(t *testing.T) {
+	requests := []string{}
+	throttled := map[string]bool{"Soccer": true}
+	concepts := map[string]map[string]interface{}{
+		"Sports": mockedConcepts["Sports"],
+		"Soccer": mockedConcepts["Soccer"],
+	}
+	retryPolicy := nytapi.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Nanosecond}
+	sut := nytapi.NewClient(newMockedSemanticHTTPClient(concepts, throttled, &requests), "mockedApiKey", nytapi.WithRetryPolicy(retryPolicy))
+
+	ctx := context.Background()
+	graphQuery := nytapi.ConceptGraphQuery{
+		ConceptType:     nytapi.ConceptTypeDescriptor,
+		SpecificConcept: "Sports",
+		MaxDepth:        1,
+		Interval:        time.Nanosecond,
+	}
+	graph, err := sut.CrawlConceptGraph(ctx, graphQuery)
+
+	require.Nil(t, err)
+	assert.Equal(t, []string{"Sports", "Baseball", "Soccer", "Soccer"}, requests)
+	expanded := map[string]bool{}
+	for _, node := range graph.Nodes {
+		expanded[node.ID] = node.Expanded
+	}
+	assert.True(t, expanded["nytd_des/Soccer"])
+	assert.False(t, expanded["nytd_des/Baseball"])
+}
+
+func Test_Client_ShouldNotRetryThrottledConceptsWithoutRetryPolicy_CrawlConceptGraph_WithValues(t *testing.T) {
+	requests := []string{}
+	throttled := map[string]bool{"Soccer": true}
+	sut := nytapi.NewClient(newMockedSemanticHTTPClient(mockedConcepts, throttled, &requests), "mockedApiKey")
+
+	ctx := context.Background()
+	graphQuery := nytapi.ConceptGraphQuery{
+		ConceptType:     nytapi.ConceptTypeDescriptor,
+		SpecificConcept: "Sports",
+		MaxDepth:        1,
+		Interval:        time.Nanosecond,
+	}
+	graph, err := sut.CrawlConceptGraph(ctx, graphQuery)
+
+	require.Nil(t, err)
+	assert.Equal(t, []string{"Sports", "Baseball", "Soccer"}, requests)
+	for _, node := range graph.Nodes {
+		if node.ID == "nytd_des/Soccer" {
+			assert.False(t, node.Expanded)
+		}
+	}
+}
+
+func Test_Client_ShouldRequestLinksAndTaxonomy_CrawlConceptGraph_WithValues(t *testing.T) {
+	fields := []string{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			fields = append(fields, req.URL.Query().Get("fields"))
+			body, _ := json.Marshal(map[string]interface{}{
+				"status":      "OK",
+				"num_results": 1,
+				"results":     []interface{}{mockedConcepts["Soccer"]},
+			})
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(body))}, nil
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "mockedApiKey")
+
+	ctx := context.Background()
+	graphQuery := nytapi.ConceptGraphQuery{
+		ConceptType:     nytapi.ConceptTypeDescriptor,
+		SpecificConcept: "Soccer",
+		MaxDepth:        1,
+		Interval:        time.Nanosecond,
+	}
+	_, err := sut.CrawlConceptGraph(ctx, graphQuery)
+
+	require.Nil(t, err)
+	assert.Equal(t, []string{"links,taxonomy", "links,taxonomy"}, fields)
+}
+
+func Test_Client_ShouldFollowTaxonomy_CrawlConceptGraph_WithValues(t *testing.T) {
+	requests := []string{}
+	football := newMockedConcept("Football", nil, nil, nil)
+	football["taxonomy"] = []map[string]string{{
+		"source_concept_name": "Football",
+		"source_concept_type": "nytd_des",
+		"target_concept_name": "Soccer",
+		"target_concept_type": "nytd_des",
+		"taxonomic_relation":  "related",
+	}}
+	concepts := map[string]map[string]interface{}{
+		"Football": football,
+		"Soccer":   newMockedConcept("Soccer", nil, nil, nil),
+	}
+	sut := nytapi.NewClient(newMockedSemanticHTTPClient(concepts, nil, &requests), "mockedApiKey")
+
+	ctx := context.Background()
+	graphQuery := nytapi.ConceptGraphQuery{
+		ConceptType:     nytapi.ConceptTypeDescriptor,
+		SpecificConcept: "Football",
+		MaxDepth:        1,
+		Interval:        time.Nanosecond,
+	}
+	graph, err := sut.CrawlConceptGraph(ctx, graphQuery)
+
+	require.Nil(t, err)
+	assert.Equal(t, []string{"Football", "Soccer"}, requests)
+	assert.Equal(t, []nytapi.ConceptEdge{{From: "nytd_des/Football", To: "nytd_des/Soccer", Relation: "related"}}, graph.Edges)
+}
+
+func Test_Client_ShouldPaceByRateLimitOnly_CrawlConceptGraph_WithValues(t *testing.T) {
+	requests := []string{}
+	rateLimit := nytapi.RateLimit{RequestsPerMinute: 60000, Burst: 10}
+	sut := nytapi.NewClient(newMockedSemanticHTTPClient(mockedConcepts, nil, &requests), "mockedApiKey", nytapi.WithRateLimit(rateLimit))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	graphQuery := nytapi.ConceptGraphQuery{
+		ConceptType:     nytapi.ConceptTypeDescriptor,
+		SpecificConcept: "Sports",
+		MaxDepth:        2,
+		Interval:        time.Hour,
+	}
+	_, err := sut.CrawlConceptGraph(ctx, graphQuery)
+
+	require.Nil(t, err)
+	assert.Equal(t, []string{"Sports", "Baseball", "Soccer", "Little League"}, requests)
+}
+
+func Test_Client_ShouldHandleUnknownStartConcept_CrawlConceptGraph_WithError(t *testing.T) {
+	requests := []string{}
+	sut := nytapi.NewClient(newMockedSemanticHTTPClient(mockedConcepts, nil, &requests), "mockedApiKey")
+
+	ctx := context.Background()
+	graphQuery := nytapi.ConceptGraphQuery{
+		ConceptType:     nytapi.ConceptTypeDescriptor,
+		SpecificConcept: "Curling",
+		MaxDepth:        1,
+		Interval:        time.Nanosecond,
+	}
+	_, err := sut.CrawlConceptGraph(ctx, graphQuery)
+
+	assert.NotNil(t, err)
+}
+
+func Test_Client_ShouldHonourCancellation_CrawlConceptGraph_WithError(t *testing.T) {
+	requests := []string{}
+	sut := nytapi.NewClient(newMockedSemanticHTTPClient(mockedConcepts, nil, &requests), "mockedApiKey")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	graphQuery := nytapi.ConceptGraphQuery{
+		ConceptType:     nytapi.ConceptTypeDescriptor,
+		SpecificConcept: "Sports",
+		MaxDepth:        1,
+		Interval:        time.Hour,
+	}
+	_, err := sut.CrawlConceptGraph(ctx, graphQuery)
+
+	assert.NotNil(t, err)
+	assert.Len(t, requests, 0)
+}
+
+func Test_ConceptGraphQuery_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		graphQuery nytapi.ConceptGraphQuery
+	}{
+		{nytapi.ConceptGraphQuery{SpecificConcept: "Sports"}},
+		{nytapi.ConceptGraphQuery{ConceptType: nytapi.ConceptTypeDescriptor}},
+		{nytapi.ConceptGraphQuery{ConceptType: nytapi.ConceptTypeDescriptor, SpecificConcept: "Sports", MaxDepth: -1}},
+		{nytapi.ConceptGraphQuery{ConceptType: nytapi.ConceptTypeDescriptor, SpecificConcept: "Sports", Interval: -time.Second}},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.graphQuery.IsValid())
+	}
+}
+
+func Test_ConceptGraph_ShouldWriteDOT_WithValues(t *testing.T) {
+	graph := nytapi.ConceptGraph{
+		Root: "nytd_des/Sports",
+		Nodes: []nytapi.ConceptNode{
+			{ID: "nytd_des/Sports", Name: "Sports", Type: "nytd_des"},
+			{ID: "nytd_des/Baseball", Name: "Baseball", Type: "nytd_des", Depth: 1},
+			{ID: "http://en.wikipedia.org/wiki/Sport", Name: "http://en.wikipedia.org/wiki/Sport", Type: nytapi.ConceptRelationLink, Depth: 1},
+		},
+		Edges: []nytapi.ConceptEdge{
+			{From: "nytd_des/Sports", To: "nytd_des/Baseball", Relation: nytapi.ConceptRelationDescendant},
+			{From: "nytd_des/Sports", To: "http://en.wikipedia.org/wiki/Sport", Relation: "sameAs"},
+		},
+	}
+
+	var buffer bytes.Buffer
+	err := graph.WriteDOT(&buffer)
+
+	require.Nil(t, err)
+	assert.Equal(t, `digraph concepts {
+	"nytd_des/Sports" [label="Sports", shape=ellipse];
+	"nytd_des/Baseball" [label="Baseball", shape=ellipse];
+	"http://en.wikipedia.org/wiki/Sport" [label="http://en.wikipedia.org/wiki/Sport", shape=box];
+	"nytd_des/Sports" -> "nytd_des/Baseball" [label="descendant"];
+	"nytd_des/Sports" -> "http://en.wikipedia.org/wiki/Sport" [label="sameAs"];
+}
+`, buffer.String())
+}
@@ -0,0 +1,68 @@
+package nytapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+func Test_ConceptType_ShouldBeValid(t *testing.T) {
+	var cases = []struct {
+		conceptType nytapi.ConceptType
+	}{
+		{nytapi.ConceptTypeDescriptor},
+		{nytapi.ConceptTypeGeographic},
+		{nytapi.ConceptTypeOrganization},
+		{nytapi.ConceptTypePerson},
+		{"nytd_per"},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.conceptType.IsValid())
+	}
+}
+
+func Test_ConceptType_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		conceptType nytapi.ConceptType
+	}{
+		{""},
+		{"per"},
+		{"NYTD_PER"},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.conceptType.IsValid())
+	}
+}
+
+func Test_ConceptField_ShouldBeValid(t *testing.T) {
+	var cases = []struct {
+		field nytapi.ConceptField
+	}{
+		{nytapi.ConceptFieldAll},
+		{nytapi.ConceptFieldLinks},
+		{nytapi.ConceptFieldGeocodes},
+		{nytapi.ConceptFieldArticleList},
+		{"search_api_query"},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.field.IsValid())
+	}
+}
+
+func Test_ConceptField_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		field nytapi.ConceptField
+	}{
+		{""},
+		{"ancestors"},
+		{"article-list"},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.field.IsValid())
+	}
+}
@@ -0,0 +1,174 @@
+// Package fq builds filter queries for the 'Article search' of the New York Times API.
+//
+// Filter queries use a Lucene-like syntax, for example
+//
+//	section_name:("Sports") AND glocations:("NEW YORK CITY")
+//
+// which can be composed from clauses and rendered via Build:
+//
+//	filter, err := fq.Build(fq.And(
+//		fq.Values(fq.SectionName, "Sports"),
+//		fq.Values(fq.Glocations, "NEW YORK CITY"),
+//	))
+package fq
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Clause of a filter query. Clauses are created via the constructors of this package
+// and can be nested arbitrarily through And, Or and Not.
+type Clause interface {
+	build() (string, error)
+}
+
+// Build renders a clause into the filter query syntax, validating all fields on the way.
+func Build(clause Clause) (string, error) {
+	if clause == nil {
+		return "", fmt.Errorf("no filter query clause given")
+	}
+	return clause.build()
+}
+
+// Values matches documents whose field equals any of the given values, e.g. section_name:("Sports" "Arts").
+func Values(field Field, values ...string) Clause {
+	return valuesClause{field: field, values: values}
+}
+
+// Phrase matches documents whose field contains the given phrase, e.g. headline:"mars rover".
+func Phrase(field Field, phrase string) Clause {
+	return phraseClause{field: field, phrase: phrase}
+}
+
+// DateRange matches documents whose date field lies within the inclusive range from and to.
+// A zero time leaves the respective side of the range open.
+func DateRange(field Field, from time.Time, to time.Time) Clause {
+	return dateRangeClause{field: field, from: from, to: to}
+}
+
+// And matches documents which satisfy all given clauses.
+func And(clauses ...Clause) Clause {
+	return groupClause{operator: "AND", clauses: clauses}
+}
+
+// Or matches documents which satisfy at least one of the given clauses.
+func Or(clauses ...Clause) Clause {
+	return groupClause{operator: "OR", clauses: clauses}
+}
+
+// Not matches documents which do not satisfy the given clause.
+func Not(clause Clause) Clause {
+	return notClause{clause: clause}
+}
+
+type valuesClause struct {
+	field  Field
+	values []string
+}
+
+func (c valuesClause) build() (string, error) {
+	if err := c.field.IsValid(); err != nil {
+		return "", err
+	}
+	if len(c.values) == 0 {
+		return "", fmt.Errorf("no values given for filter query field: %v", c.field)
+	}
+
+	quoted := make([]string, len(c.values))
+	for i, value := range c.values {
+		quoted[i] = quote(value)
+	}
+	return fmt.Sprintf("%v:(%v)", c.field, strings.Join(quoted, " ")), nil
+}
+
+type phraseClause struct {
+	field  Field
+	phrase string
+}
+
+func (c phraseClause) build() (string, error) {
+	if err := c.field.IsValid(); err != nil {
+		return "", err
+	}
+	if c.phrase == "" {
+		return "", fmt.Errorf("no phrase given for filter query field: %v", c.field)
+	}
+	return fmt.Sprintf("%v:%v", c.field, quote(c.phrase)), nil
+}
+
+type dateRangeClause struct {
+	field Field
+	from  time.Time
+	to    time.Time
+}
+
+func (c dateRangeClause) build() (string, error) {
+	if err := c.field.IsValid(); err != nil {
+		return "", err
+	}
+	if !c.from.IsZero() && !c.to.IsZero() && c.to.Before(c.from) {
+		return "", fmt.Errorf("invalid date range for filter query field %v: %v - %v", c.field, formatDate(c.from), formatDate(c.to))
+	}
+	return fmt.Sprintf("%v:[%v TO %v]", c.field, formatDate(c.from), formatDate(c.to)), nil
+}
+
+type groupClause struct {
+	operator string
+	clauses  []Clause
+}
+
+func (c groupClause) build() (string, error) {
+	if len(c.clauses) == 0 {
+		return "", fmt.Errorf("no clauses given for filter query %v group", c.operator)
+	}
+
+	parts := make([]string, len(c.clauses))
+	for i, clause := range c.clauses {
+		part, err := buildNested(clause)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, " "+c.operator+" "), nil
+}
+
+type notClause struct {
+	clause Clause
+}
+
+func (c notClause) build() (string, error) {
+	part, err := buildNested(c.clause)
+	if err != nil {
+		return "", err
+	}
+	return "NOT " + part, nil
+}
+
+// Builds a clause which is embedded into another one, wrapping groups in parentheses
+// to keep the operator precedence intact.
+func buildNested(clause Clause) (string, error) {
+	part, err := Build(clause)
+	if err != nil {
+		return "", err
+	}
+	if group, ok := clause.(groupClause); ok && len(group.clauses) > 1 {
+		return "(" + part + ")", nil
+	}
+	return part, nil
+}
+
+// Quotes a value, escaping backslashes and double quotes within.
+func quote(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+	return `"` + escaped + `"`
+}
+
+func formatDate(date time.Time) string {
+	if date.IsZero() {
+		return "*"
+	}
+	return date.UTC().Format(time.RFC3339)
+}
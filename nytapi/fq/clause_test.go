@@ -0,0 +1,86 @@
+package fq_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/nytapi/fq"
+)
+
+func Test_Build_ShouldRenderClauses_WithValue(t *testing.T) {
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	var cases = []struct {
+		clause   fq.Clause
+		expected string
+	}{
+		{fq.Values(fq.SectionName, "Sports"), `section_name:("Sports")`},
+		{fq.Values(fq.NewsDesk, "Sports", "Foreign"), `news_desk:("Sports" "Foreign")`},
+		{fq.Phrase(fq.Headline, "mars rover"), `headline:"mars rover"`},
+		{fq.DateRange(fq.PubDate, from, to), `pub_date:[2021-01-01T00:00:00Z TO 2021-01-31T00:00:00Z]`},
+		{fq.DateRange(fq.PubDate, from, time.Time{}), `pub_date:[2021-01-01T00:00:00Z TO *]`},
+		{fq.Not(fq.Values(fq.TypeOfMaterial, "Op-Ed")), `NOT type_of_material:("Op-Ed")`},
+		{
+			fq.And(fq.Values(fq.SectionName, "Sports"), fq.Values(fq.Glocations, "NEW YORK CITY")),
+			`section_name:("Sports") AND glocations:("NEW YORK CITY")`,
+		},
+		{
+			fq.And(fq.Values(fq.SectionName, "Sports"), fq.Or(fq.Values(fq.Persons, "Jeter, Derek"), fq.Not(fq.Values(fq.Subject, "Baseball")))),
+			`section_name:("Sports") AND (persons:("Jeter, Derek") OR NOT subject:("Baseball"))`,
+		},
+		{fq.And(fq.Values(fq.SectionName, "Sports")), `section_name:("Sports")`},
+	}
+
+	for _, tt := range cases {
+		filter, err := fq.Build(tt.clause)
+
+		require.Nil(t, err)
+		assert.Equal(t, tt.expected, filter)
+	}
+}
+
+func Test_Build_ShouldEscapeValues_WithValue(t *testing.T) {
+	var cases = []struct {
+		clause   fq.Clause
+		expected string
+	}{
+		{fq.Values(fq.Headline, `The "Big" Apple`), `headline:("The \"Big\" Apple")`},
+		{fq.Phrase(fq.Body, `back\slash`), `body:"back\\slash"`},
+		{fq.Values(fq.Subject, `a) OR web_url:("b`), `subject:("a) OR web_url:(\"b")`},
+	}
+
+	for _, tt := range cases {
+		filter, err := fq.Build(tt.clause)
+
+		require.Nil(t, err)
+		assert.Equal(t, tt.expected, filter)
+	}
+}
+
+func Test_Build_ShouldFail_WithError(t *testing.T) {
+	from := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var cases = []struct {
+		clause fq.Clause
+	}{
+		{nil},
+		{fq.Values(fq.Field("not_a_field"), "Sports")},
+		{fq.Values(fq.SectionName)},
+		{fq.Phrase(fq.Headline, "")},
+		{fq.DateRange(fq.PubDate, from, to)},
+		{fq.And()},
+		{fq.Or(fq.Values(fq.SectionName, "Sports"), fq.Values(fq.Field("Section_Name"), "Arts"))},
+		{fq.Not(nil)},
+	}
+
+	for _, tt := range cases {
+		filter, err := fq.Build(tt.clause)
+
+		assert.Empty(t, filter)
+		assert.Error(t, err)
+	}
+}
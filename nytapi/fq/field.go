@@ -0,0 +1,48 @@
+package fq
+
+import "fmt"
+
+// Field which can be filtered on in an 'Article search' filter query as defined by the New York Times API.
+type Field string
+
+// Valid filter query field as defined by the New York Times API.
+const (
+	Body                  Field = "body"
+	BodySearch            Field = "body.search"
+	CreativeWorks         Field = "creative_works"
+	CreativeWorksContains Field = "creative_works.contains"
+	DayOfWeek             Field = "day_of_week"
+	DocumentType          Field = "document_type"
+	Glocations            Field = "glocations"
+	GlocationsContains    Field = "glocations.contains"
+	Headline              Field = "headline"
+	HeadlineSearch        Field = "headline.search"
+	Kicker                Field = "kicker"
+	KickerContains        Field = "kicker.contains"
+	NewsDesk              Field = "news_desk"
+	NewsDeskContains      Field = "news_desk.contains"
+	Organizations         Field = "organizations"
+	OrganizationsContains Field = "organizations.contains"
+	Persons               Field = "persons"
+	PersonsContains       Field = "persons.contains"
+	PubDate               Field = "pub_date"
+	PubYear               Field = "pub_year"
+	Secpg                 Field = "secpg"
+	Source                Field = "source"
+	SourceContains        Field = "source.contains"
+	Subject               Field = "subject"
+	SubjectContains       Field = "subject.contains"
+	SectionName           Field = "section_name"
+	TypeOfMaterial        Field = "type_of_material"
+	WebURL                Field = "web_url"
+	WordCount             Field = "word_count"
+)
+
+// IsValid checks the validity of a filter query field.
+func (field Field) IsValid() error {
+	switch field {
+	case Body, BodySearch, CreativeWorks, CreativeWorksContains, DayOfWeek, DocumentType, Glocations, GlocationsContains, Headline, HeadlineSearch, Kicker, KickerContains, NewsDesk, NewsDeskContains, Organizations, OrganizationsContains, Persons, PersonsContains, PubDate, PubYear, Secpg, Source, SourceContains, Subject, SubjectContains, SectionName, TypeOfMaterial, WebURL, WordCount:
+		return nil
+	}
+	return fmt.Errorf("invalid filter query field: %v", field)
+}
@@ -0,0 +1,81 @@
+package fq_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thorstenpfister/gonyt/nytapi/fq"
+)
+
+func Test_Field_ShouldBeReflectingField_WithValue(t *testing.T) {
+	var cases = []struct {
+		field         fq.Field
+		expectedField fq.Field
+	}{
+		{fq.Field("body"), fq.Body},
+		{fq.Field("glocations"), fq.Glocations},
+		{fq.Field("headline.search"), fq.HeadlineSearch},
+		{fq.Field("news_desk"), fq.NewsDesk},
+		{fq.Field("pub_date"), fq.PubDate},
+		{fq.Field("section_name"), fq.SectionName},
+		{fq.Field("type_of_material"), fq.TypeOfMaterial},
+	}
+
+	for _, tt := range cases {
+		assert.Equal(t, tt.field, tt.expectedField)
+	}
+}
+
+func Test_Field_ShouldBeValid(t *testing.T) {
+	var cases = []struct {
+		field fq.Field
+	}{
+		{fq.Body},
+		{fq.BodySearch},
+		{fq.CreativeWorks},
+		{fq.CreativeWorksContains},
+		{fq.DayOfWeek},
+		{fq.DocumentType},
+		{fq.Glocations},
+		{fq.GlocationsContains},
+		{fq.Headline},
+		{fq.HeadlineSearch},
+		{fq.Kicker},
+		{fq.KickerContains},
+		{fq.NewsDesk},
+		{fq.NewsDeskContains},
+		{fq.Organizations},
+		{fq.OrganizationsContains},
+		{fq.Persons},
+		{fq.PersonsContains},
+		{fq.PubDate},
+		{fq.PubYear},
+		{fq.Secpg},
+		{fq.Source},
+		{fq.SourceContains},
+		{fq.Subject},
+		{fq.SubjectContains},
+		{fq.SectionName},
+		{fq.TypeOfMaterial},
+		{fq.WebURL},
+		{fq.WordCount},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.field.IsValid())
+	}
+}
+
+func Test_Field_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		field fq.Field
+	}{
+		{fq.Field("not-valid")},
+		{"also-not-valid"},
+		{"Section_Name"},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.field.IsValid())
+	}
+}
@@ -0,0 +1,33 @@
+package nytapi
+
+import (
+	"context"
+	"sync"
+)
+
+// geoFacetCache holds the places geo facets resolved to, as the same facets recur across many articles.
+// Facets without a matching place are cached as nil, so that they are not looked up again either.
+type geoFacetCache struct {
+	mutex  sync.Mutex
+	places map[string]*Geo
+}
+
+// get returns the cached place of a facet, looking it up on first use. Failed lookups are not cached.
+func (cache *geoFacetCache) get(ctx context.Context, facet string, fetch func(context.Context, string) (*Geo, error)) (*Geo, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if place, ok := cache.places[facet]; ok {
+		return place, nil
+	}
+
+	place, err := fetch(ctx, facet)
+	if err != nil {
+		return nil, err
+	}
+	if cache.places == nil {
+		cache.places = map[string]*Geo{}
+	}
+	cache.places[facet] = place
+	return place, nil
+}
@@ -39,3 +39,20 @@ func (period MostPopularPeriod) IsValid() error {
 	}
 	return fmt.Errorf("invalid most popular period: %v", period)
 }
+
+// MostPopularShareType defined by the New York Times API as: facebook
+type MostPopularShareType string
+
+// Valid most popular share type as defined by the New York Times API.
+const (
+	Facebook MostPopularShareType = "facebook"
+)
+
+// IsValid checks the validity of a most popular share type.
+func (shareType MostPopularShareType) IsValid() error {
+	switch shareType {
+	case Facebook:
+		return nil
+	}
+	return fmt.Errorf("invalid most popular share type: %v", shareType)
+}
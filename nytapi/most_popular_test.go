@@ -120,3 +120,30 @@ func Test_MostPopularPeriod_ShouldBeInvalid(t *testing.T) {
 		assert.Error(t, tt.period.IsValid())
 	}
 }
+
+func Test_MostPopularShareType_ShouldBeValid(t *testing.T) {
+	var cases = []struct {
+		shareType nytapi.MostPopularShareType
+	}{
+		{nytapi.Facebook},
+		{"facebook"},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.shareType.IsValid())
+	}
+}
+
+func Test_MostPopularShareType_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		shareType nytapi.MostPopularShareType
+	}{
+		{""},
+		{"Facebook"},
+		{"myspace"},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.shareType.IsValid())
+	}
+}
@@ -0,0 +1,97 @@
+package nytapi
+
+import (
+	"fmt"
+	"time"
+)
+
+// MovieReviewOrder defined by the New York Times API as either: by-opening-date, by-publication-date, by-title
+type MovieReviewOrder string
+
+// Valid 'Movie reviews' order as defined by the New York Times API.
+const (
+	ByOpeningDate     MovieReviewOrder = "by-opening-date"
+	ByPublicationDate MovieReviewOrder = "by-publication-date"
+	ByTitle           MovieReviewOrder = "by-title"
+)
+
+// IsValid checks the validity of a 'Movie reviews' order.
+func (order MovieReviewOrder) IsValid() error {
+	switch order {
+	case ByOpeningDate, ByPublicationDate, ByTitle:
+		return nil
+	}
+	return fmt.Errorf("invalid movie review order: %v", order)
+}
+
+// MovieReviewsPageSize is the number of reviews the 'Movie reviews' API delivers per page.
+const MovieReviewsPageSize = 20
+
+// Groups of critics which can be fetched instead of a single critic by name.
+const (
+	AllCritics      = "all"
+	FullTimeCritics = "full-time"
+	PartTimeCritics = "part-time"
+)
+
+// MovieReviewQuery holds the parameters of a 'Movie reviews' search.
+// Zero values are omitted from the request, date ranges have to be given with both ends
+// and the offset has to be a multiple of MovieReviewsPageSize.
+type MovieReviewQuery struct {
+	Query               string
+	Reviewer            string
+	CriticsPick         bool
+	OpeningDateFrom     time.Time
+	OpeningDateTo       time.Time
+	PublicationDateFrom time.Time
+	PublicationDateTo   time.Time
+	Order               MovieReviewOrder
+	Offset              int
+}
+
+// IsValid checks the validity of a 'Movie reviews' search query.
+func (q MovieReviewQuery) IsValid() error {
+	if q.Order != "" {
+		if err := q.Order.IsValid(); err != nil {
+			return err
+		}
+	}
+	if err := isValidMovieReviewOffset(q.Offset); err != nil {
+		return err
+	}
+	if err := isValidMovieReviewDateRange(q.OpeningDateFrom, q.OpeningDateTo); err != nil {
+		return err
+	}
+	return isValidMovieReviewDateRange(q.PublicationDateFrom, q.PublicationDateTo)
+}
+
+func isValidMovieReviewOffset(offset int) error {
+	if offset < 0 || offset%MovieReviewsPageSize != 0 {
+		return fmt.Errorf("invalid movie review offset: %v", offset)
+	}
+	return nil
+}
+
+func isValidMovieReviewDateRange(from time.Time, to time.Time) error {
+	if from.IsZero() != to.IsZero() || to.Before(from) {
+		return fmt.Errorf("invalid movie review date range: %v - %v", formatMovieReviewDate(from), formatMovieReviewDate(to))
+	}
+	return nil
+}
+
+const movieReviewDateFormat = "2006-01-02"
+
+func formatMovieReviewDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return date.Format(movieReviewDateFormat)
+}
+
+// Formats a date range as expected by the API: YYYY-MM-DD:YYYY-MM-DD
+func formatMovieReviewDateRange(from time.Time, to time.Time) string {
+	if from.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v", formatMovieReviewDate(from), formatMovieReviewDate(to))
+}
@@ -0,0 +1,73 @@
+package nytapi_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+func Test_MovieReviewOrder_ShouldBeValid(t *testing.T) {
+	var cases = []struct {
+		order nytapi.MovieReviewOrder
+	}{
+		{nytapi.ByOpeningDate},
+		{nytapi.ByPublicationDate},
+		{nytapi.ByTitle},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.order.IsValid())
+	}
+}
+
+func Test_MovieReviewOrder_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		order nytapi.MovieReviewOrder
+	}{
+		{""},
+		{"by-rating"},
+		{"title"},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.order.IsValid())
+	}
+}
+
+func Test_MovieReviewQuery_ShouldBeValid(t *testing.T) {
+	day := time.Date(1998, time.March, 6, 0, 0, 0, 0, time.UTC)
+
+	var cases = []struct {
+		reviewQuery nytapi.MovieReviewQuery
+	}{
+		{nytapi.MovieReviewQuery{}},
+		{nytapi.MovieReviewQuery{Query: "lebowski", Order: nytapi.ByTitle, Offset: 40}},
+		{nytapi.MovieReviewQuery{OpeningDateFrom: day, OpeningDateTo: day}},
+		{nytapi.MovieReviewQuery{PublicationDateFrom: day, PublicationDateTo: day.AddDate(1, 0, 0)}},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.reviewQuery.IsValid())
+	}
+}
+
+func Test_MovieReviewQuery_ShouldBeInvalid(t *testing.T) {
+	day := time.Date(1998, time.March, 6, 0, 0, 0, 0, time.UTC)
+
+	var cases = []struct {
+		reviewQuery nytapi.MovieReviewQuery
+	}{
+		{nytapi.MovieReviewQuery{Order: "by-rating"}},
+		{nytapi.MovieReviewQuery{Offset: -20}},
+		{nytapi.MovieReviewQuery{Offset: 10}},
+		{nytapi.MovieReviewQuery{OpeningDateFrom: day}},
+		{nytapi.MovieReviewQuery{PublicationDateTo: day}},
+		{nytapi.MovieReviewQuery{OpeningDateFrom: day, OpeningDateTo: day.AddDate(0, 0, -1)}},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.reviewQuery.IsValid())
+	}
+}
@@ -0,0 +1,106 @@
+package nytapi
+
+import (
+	"strings"
+	"time"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+)
+
+// DefaultBaseURL of the New York Times API, under which all APIs except the RSS feeds are served.
+const DefaultBaseURL = "https://api.nytimes.com/svc"
+
+// Quotas of an API key of the New York Times API, see WithRateLimit.
+const (
+	DefaultRequestsPerMinute = port.DefaultRequestsPerMinute
+	DefaultDailyBudget       = port.DefaultDailyBudget
+)
+
+// DefaultKeyCooldown an API key rests after exceeding its quota, see WithKeyCooldown.
+const DefaultKeyCooldown = port.DefaultKeyCooldown
+
+// Option configures a Client on creation, see NewClient.
+type Option func(*Client)
+
+// WithBaseURL changes the base URL all API requests are sent to, e.g. to a caching proxy or a local stub server.
+// The URL takes the place of https://api.nytimes.com/svc, so paths like /topstories/v2/home.json are appended to it.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.port.BaseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithRSSBaseURL changes the base URL RSS feeds are fetched from, see UseRSSBaseURL.
+func WithRSSBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.UseRSSBaseURL(baseURL)
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent along with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.port.UserAgent = userAgent
+	}
+}
+
+// WithDefaultTimeout limits the duration of every request whose context carries no deadline of its own.
+// A timeout of zero disables the limit, leaving it to the HTTP client.
+// For streamed responses like FetchArchive the timeout covers reading the whole body, not just the headers.
+func WithDefaultTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.port.DefaultTimeout = timeout
+	}
+}
+
+// WithRequestHook adds a hook called with every request right before its execution.
+// Hooks are called in the order they were added, the first one returning an error aborts the request.
+func WithRequestHook(hook RequestHook) Option {
+	return func(c *Client) {
+		c.port.RequestHooks = append(c.port.RequestHooks, hook)
+	}
+}
+
+// WithRetries retries requests failing with errors which are safe to retry the given number of times,
+// using an exponential backoff with jitter while honouring the Retry-After header of the API.
+func WithRetries(retries int) Option {
+	return WithRetryPolicy(port.NewRetryPolicy(retries))
+}
+
+// WithRetryPolicy sets the policy deciding whether and when failed requests are retried.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.port.RetryPolicy = policy
+	}
+}
+
+// WithRateLimit limits the requests sent with each API key to a per-minute rate and a daily budget, e.g. to the quota
+// of an API key using DefaultRequestsPerMinute and DefaultDailyBudget. Retries count as requests.
+func WithRateLimit(limit RateLimit) Option {
+	return func(c *Client) {
+		c.rateLimit = limit
+	}
+}
+
+// WithAPIKeys adds further API keys, e.g. of several registered apps. Keys are used in turn, the client rotating
+// to the next healthy key whenever a key exceeds its quota, empty and duplicate keys are ignored.
+func WithAPIKeys(keys ...string) Option {
+	return func(c *Client) {
+		c.apiKeys = append(c.apiKeys, keys...)
+	}
+}
+
+// WithKeyCooldown sets how long an API key rests after exceeding its quota, by default DefaultKeyCooldown.
+// A longer Retry-After of the API takes precedence.
+func WithKeyCooldown(cooldown time.Duration) Option {
+	return func(c *Client) {
+		c.keyCooldown = cooldown
+	}
+}
+
+// WithLiveSections enables the validation of 'Top stories' sections against the section list, see UseLiveSections.
+func WithLiveSections(enabled bool) Option {
+	return func(c *Client) {
+		c.UseLiveSections(enabled)
+	}
+}
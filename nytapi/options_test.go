@@ -0,0 +1,338 @@
+package nytapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+// Call of a single endpoint of the client with valid parameters.
+type endpointCall struct {
+	name string
+	call func(ctx context.Context, c *nytapi.Client) error
+}
+
+// Calls every endpoint of the client which sends requests to the API.
+func allEndpointCalls() []endpointCall {
+	return []endpointCall{
+		{"FetchTopStories", func(ctx context.Context, c *nytapi.Client) error {
+			_, _, err := c.FetchTopStories(ctx, nytapi.Arts)
+			return err
+		}},
+		{"FetchBookReviews", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchBookReviews(ctx, nytapi.Author, "Stephen King")
+			return err
+		}},
+		{"FetchMostPopularArticles", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchMostPopularArticles(ctx, nytapi.Viewed, nytapi.Day)
+			return err
+		}},
+		{"FetchMostShared", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchMostShared(ctx, nytapi.Day, nytapi.Facebook)
+			return err
+		}},
+		{"SearchArticles", func(ctx context.Context, c *nytapi.Client) error {
+			_, _, err := c.SearchArticles(ctx, nytapi.ArticleSearchQuery{Query: "election"})
+			return err
+		}},
+		{"FetchArchive", func(ctx context.Context, c *nytapi.Client) error {
+			return c.FetchArchive(ctx, 2019, time.January, func(nytapi.ArchiveDocument) error { return nil })
+		}},
+		{"FetchBestsellerList", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchBestsellerList(ctx, "hardcover-fiction", time.Time{})
+			return err
+		}},
+		{"FetchBestsellerListNames", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchBestsellerListNames(ctx)
+			return err
+		}},
+		{"FetchBestsellerOverview", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchBestsellerOverview(ctx, time.Time{})
+			return err
+		}},
+		{"FetchBestsellerHistory", func(ctx context.Context, c *nytapi.Client) error {
+			_, _, err := c.FetchBestsellerHistory(ctx, nytapi.BestsellerHistoryQuery{Author: "Stephen King"})
+			return err
+		}},
+		{"SearchMovieReviews", func(ctx context.Context, c *nytapi.Client) error {
+			_, _, err := c.SearchMovieReviews(ctx, nytapi.MovieReviewQuery{Query: "lebowski"})
+			return err
+		}},
+		{"FetchCriticsPicks", func(ctx context.Context, c *nytapi.Client) error {
+			_, _, err := c.FetchCriticsPicks(ctx, "", 0)
+			return err
+		}},
+		{"FetchCritics", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchCritics(ctx, "all")
+			return err
+		}},
+		{"FetchWireContent", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchWireContent(ctx, nytapi.AllSources, "all", 20, 0)
+			return err
+		}},
+		{"FetchSectionList", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchSectionList(ctx)
+			return err
+		}},
+		{"FetchWireByURL", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchWireByURL(ctx, "https://www.nytimes.com/2021/04/17/theater/scott-rudin-steps-away-from-broadway.html")
+			return err
+		}},
+		{"SearchConcepts", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.SearchConcepts(ctx, "Obama", nytapi.ConceptTypePerson)
+			return err
+		}},
+		{"FetchConcept", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchConcept(ctx, nytapi.ConceptTypePerson, "Obama, Barack", nil)
+			return err
+		}},
+		{"SuggestTags", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.SuggestTags(ctx, "Obama", nil, 0)
+			return err
+		}},
+		{"FetchComments", func(ctx context.Context, c *nytapi.Client) error {
+			_, _, err := c.FetchComments(ctx, "https://www.nytimes.com/2021/04/17/theater/scott-rudin-steps-away-from-broadway.html", 0, "")
+			return err
+		}},
+		{"FetchCommentReplies", func(ctx context.Context, c *nytapi.Client) error {
+			_, _, err := c.FetchCommentReplies(ctx, "https://www.nytimes.com/2021/04/17/theater/scott-rudin-steps-away-from-broadway.html", 1, 0)
+			return err
+		}},
+		{"FetchGeo", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.FetchGeo(ctx, "Paris")
+			return err
+		}},
+		{"DoRaw", func(ctx context.Context, c *nytapi.Client) error {
+			_, err := c.DoRaw(ctx, "books/v3/lists/names.json", nil)
+			return err
+		}},
+	}
+}
+
+func Test_Client_ShouldRespectOptions_AllEndpoints_WithValues(t *testing.T) {
+	for _, tt := range allEndpointCalls() {
+		var requestedURL, userAgent string
+		var hasDeadline bool
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				requestedURL = req.URL.String()
+				userAgent = req.Header.Get("User-Agent")
+				_, hasDeadline = req.Context().Deadline()
+				return &http.Response{StatusCode: 404}, nil
+			},
+		}
+		hookCalls := 0
+		sut := nytapi.NewClient(&mockedHTTPClient, "mockedApiKey",
+			nytapi.WithBaseURL("http://localhost:8080/proxy/"),
+			nytapi.WithUserAgent("gonyt-test/1.0"),
+			nytapi.WithDefaultTimeout(time.Minute),
+			nytapi.WithRequestHook(func(*http.Request) error {
+				hookCalls++
+				return nil
+			}),
+		)
+
+		err := tt.call(context.Background(), &sut)
+
+		assert.NotNil(t, err, tt.name)
+		assert.True(t, strings.HasPrefix(requestedURL, "http://localhost:8080/proxy/"), "%v requested %v", tt.name, requestedURL)
+		assert.NotContains(t, requestedURL, "proxy//", tt.name)
+		assert.Equal(t, "gonyt-test/1.0", userAgent, tt.name)
+		assert.True(t, hasDeadline, tt.name)
+		assert.Equal(t, 1, hookCalls, tt.name)
+	}
+}
+
+func Test_Client_ShouldUseDefaults_WithoutOptions_WithValues(t *testing.T) {
+	var requestedURL string
+	var hasDeadline bool
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL.String()
+			_, hasDeadline = req.Context().Deadline()
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "mockedApiKey")
+
+	_, err := sut.FetchBestsellerListNames(context.Background())
+
+	assert.NotNil(t, err)
+	assert.True(t, strings.HasPrefix(requestedURL, nytapi.DefaultBaseURL+"/"))
+	assert.False(t, hasDeadline)
+}
+
+func Test_Client_ShouldRespectOptions_FetchRSSFeed_WithValues(t *testing.T) {
+	var requestedURL, userAgent string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL.String()
+			userAgent = req.Header.Get("User-Agent")
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "mockedApiKey",
+		nytapi.WithBaseURL("http://localhost:8080/proxy"),
+		nytapi.WithRSSBaseURL("http://localhost:8081/rss/"),
+		nytapi.WithUserAgent("gonyt-test/1.0"),
+	)
+
+	_, _, err := sut.FetchRSSFeed(context.Background(), "World")
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "http://localhost:8081/rss/World.xml", requestedURL)
+	assert.Equal(t, "gonyt-test/1.0", userAgent)
+}
+
+func Test_Client_ShouldAbortOnRequestHookError_WithError(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 200}, nil
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "mockedApiKey",
+		nytapi.WithRequestHook(func(*http.Request) error {
+			return errors.New("blocked by hook")
+		}),
+	)
+
+	listNames, err := sut.FetchBestsellerListNames(context.Background())
+
+	require.Nil(t, listNames)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "blocked by hook")
+	}
+	assert.Equal(t, 0, requests)
+}
+
+func Test_Client_ShouldRetryTemporaryErrors_WithRetryPolicy_WithValues(t *testing.T) {
+	statusCodes := []int{503, 502, 404}
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: statusCodes[requests-1]}, nil
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "mockedApiKey",
+		nytapi.WithRetryPolicy(nytapi.RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond}),
+	)
+
+	listNames, err := sut.FetchBestsellerListNames(context.Background())
+
+	require.Nil(t, listNames)
+	if assert.IsType(t, apierror.APIError{}, err) {
+		assert.Equal(t, apierror.NotFoundError, err.(apierror.APIError).Type)
+	}
+	assert.Equal(t, 3, requests)
+}
+
+func Test_Client_ShouldFailFastOnRateLimit_WithRateLimit_WithError(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "mockedApiKey",
+		nytapi.WithRateLimit(nytapi.RateLimit{RequestsPerMinute: nytapi.DefaultRequestsPerMinute, FailFast: true}),
+	)
+	ctx := context.Background()
+
+	_, err := sut.FetchBestsellerListNames(ctx)
+	assert.IsType(t, apierror.APIError{}, err)
+
+	listNames, err := sut.FetchBestsellerListNames(ctx)
+
+	require.Nil(t, listNames)
+	assert.IsType(t, nytapi.RateLimitError{}, err)
+	assert.Equal(t, 1, requests)
+}
+
+func Test_Client_ShouldNotRateLimit_FetchRSSFeed_WithValues(t *testing.T) {
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "mockedApiKey",
+		nytapi.WithRateLimit(nytapi.RateLimit{DailyBudget: 1, FailFast: true}),
+	)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, _, err := sut.FetchRSSFeed(ctx, "World")
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+	assert.Equal(t, 3, requests)
+}
+
+func Test_Client_ShouldRotateAPIKeys_WithAPIKeys_WithValues(t *testing.T) {
+	usedKeys := []string{}
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			key := req.URL.Query().Get("api-key")
+			usedKeys = append(usedKeys, key)
+			if key == "firstApiKey" {
+				return &http.Response{StatusCode: 429}, nil
+			}
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "firstApiKey",
+		nytapi.WithAPIKeys("secondApiKey", "firstApiKey"),
+		nytapi.WithKeyCooldown(time.Hour),
+	)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := sut.FetchBestsellerListNames(ctx)
+		if assert.IsType(t, apierror.APIError{}, err) {
+			assert.Equal(t, apierror.NotFoundError, err.(apierror.APIError).Type)
+		}
+	}
+	usage, err := sut.KeyUsage()
+
+	assert.Equal(t, []string{"firstApiKey", "secondApiKey", "secondApiKey"}, usedKeys)
+	require.Nil(t, err)
+	if assert.Len(t, usage, 2) {
+		assert.Equal(t, nytapi.KeyFingerprint("firstApiKey"), usage[0].Fingerprint)
+		assert.Equal(t, 1, usage[0].RateLimited)
+		assert.True(t, usage[0].CoolingUntil.After(time.Now()))
+		assert.Equal(t, nytapi.KeyFingerprint("secondApiKey"), usage[1].Fingerprint)
+		assert.Equal(t, 2, usage[1].Requests)
+	}
+}
+
+func Test_Client_ShouldReportNoKeyUsage_WithoutAPIKey_WithValues(t *testing.T) {
+	var rawQuery string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			rawQuery = req.URL.RawQuery
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "")
+
+	_, err := sut.FetchBestsellerListNames(context.Background())
+	usage, usageErr := sut.KeyUsage()
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "", rawQuery)
+	require.Nil(t, usageErr)
+	assert.Empty(t, usage)
+}
@@ -0,0 +1,35 @@
+package nytapi
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Splits a raw path into the path below the base URL and its query parameters. Paths are accepted as listed
+// in the API documentation, with or without the leading /svc, as well as full URLs of api.nytimes.com.
+// Parameters given explicitly take precedence over those of the path.
+func normalizeRawPath(rawPath string, params url.Values) (string, url.Values, error) {
+	parsed, err := url.Parse(strings.TrimSpace(rawPath))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid raw path: %v", rawPath)
+	}
+	if parsed.Scheme != "" || parsed.Host != "" {
+		if parsed.Scheme != "https" || strings.ToLower(parsed.Host) != "api.nytimes.com" {
+			return "", nil, fmt.Errorf("invalid raw path, not on https://api.nytimes.com: %v", rawPath)
+		}
+	}
+
+	cleaned := strings.TrimPrefix(path.Clean("/"+parsed.Path), "/")
+	cleaned = strings.TrimPrefix(cleaned, "svc/")
+	if cleaned == "" || cleaned == "svc" {
+		return "", nil, fmt.Errorf("invalid raw path: path must not be empty")
+	}
+
+	merged := parsed.Query()
+	for key, values := range params {
+		merged[key] = values
+	}
+	return cleaned, merged, nil
+}
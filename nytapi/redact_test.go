@@ -0,0 +1,68 @@
+package nytapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+func Test_Client_ShouldRedactAPIKeyFromErrors_AllEndpoints_WithError(t *testing.T) {
+	for _, tt := range allEndpointCalls() {
+		mockedHTTPClient := port.MockedHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return nil, &url.Error{Op: "Get", URL: req.URL.String(), Err: errors.New("dial tcp: connection refused")}
+			},
+		}
+		sut := nytapi.NewClient(&mockedHTTPClient, "secretApiKey", nytapi.WithAPIKeys("otherSecretApiKey"))
+
+		err := tt.call(context.Background(), &sut)
+
+		if assert.NotNil(t, err, tt.name) {
+			assert.NotContains(t, err.Error(), "secretApiKey", tt.name)
+			assert.NotContains(t, err.Error(), "SecretApiKey", tt.name)
+			assert.Contains(t, err.Error(), "api-key=REDACTED", tt.name)
+		}
+	}
+}
+
+func Test_Client_ShouldRedactAPIKeyFromErrors_Iterators_WithError(t *testing.T) {
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return nil, &url.Error{Op: "Get", URL: req.URL.String(), Err: errors.New("dial tcp: connection refused")}
+		},
+	}
+	sut := nytapi.NewClient(&mockedHTTPClient, "secretApiKey")
+	ctx := context.Background()
+
+	articles := sut.IterateArticles(ctx, nytapi.ArticleSearchQuery{Query: "election"})
+	comments := sut.IterateComments(ctx, "https://www.nytimes.com/2021/04/17/theater/scott-rudin-steps-away-from-broadway.html", "")
+	_, graphErr := sut.CrawlConceptGraph(ctx, nytapi.ConceptGraphQuery{ConceptType: nytapi.ConceptTypeDescriptor, SpecificConcept: "Sports"})
+
+	assert.False(t, articles.Next())
+	assert.False(t, comments.Next())
+	for _, err := range []error{articles.Err(), comments.Err(), graphErr} {
+		if assert.NotNil(t, err) {
+			assert.NotContains(t, err.Error(), "secretApiKey")
+		}
+	}
+}
+
+func Test_Client_ShouldRedactAPIKeys_Redact_WithValue(t *testing.T) {
+	sut := nytapi.NewClient(&port.MockedHTTPClient{}, "secretApiKey", nytapi.WithAPIKeys("otherSecretApiKey"))
+
+	redacted := sut.Redact("GET https://api.nytimes.com/svc/books/v3/lists/names.json?api-key=secretApiKey with otherSecretApiKey")
+
+	assert.Equal(t, "GET https://api.nytimes.com/svc/books/v3/lists/names.json?api-key=REDACTED with REDACTED", redacted)
+}
+
+func Test_RedactAPIKeys_WithValue(t *testing.T) {
+	redacted := nytapi.RedactAPIKeys("first key, second key, ?api-key=third", "first", "second")
+
+	assert.Equal(t, "REDACTED key, REDACTED key, ?api-key=REDACTED", redacted)
+}
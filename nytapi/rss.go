@@ -0,0 +1,34 @@
+package nytapi
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/thorstenpfister/gonyt/internal/nytapi"
+)
+
+// RSSDefaultBaseURL under which the New York Times publishes its RSS feeds, e.g. https://rss.nytimes.com/services/xml/rss/nyt/World.xml
+const RSSDefaultBaseURL = "https://rss.nytimes.com/services/xml/rss/nyt"
+
+// ParseRSSFeed parses an RSS feed of the New York Times, converting its items into articles.
+// It returns the articles along with the time the feed was last updated.
+func ParseRSSFeed(r io.Reader) (*[]Article, *time.Time, error) {
+	feed, err := nytapi.DecodeRSSFeed(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	articles, lastUpdated := feed.Articles()
+	return articles, lastUpdated, nil
+}
+
+// Reduces a feed to its name as used in the feed URL, accepting names with and without the .xml extension.
+func normalizeRSSFeed(feed string) (string, error) {
+	feed = strings.TrimSuffix(strings.TrimSpace(feed), ".xml")
+	if feed == "" || strings.ContainsAny(feed, "/?#") {
+		return "", fmt.Errorf("invalid rss feed: %v", feed)
+	}
+	return feed, nil
+}
@@ -0,0 +1,174 @@
+package nytapi_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/apierror"
+	"github.com/thorstenpfister/gonyt/internal/nytapi/port"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+const rssFeedXML = `<?xml version="1.0" encoding="UTF-8"?>
+<rss xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:media="http://search.yahoo.com/mrss/" version="2.0">
+	<channel>
+		<title>NYT &gt; Technology</title>
+		<link>https://www.nytimes.com/section/technology</link>
+		<lastBuildDate>Tue, 06 Jul 2021 09:12:03 +0000</lastBuildDate>
+		<item>
+			<title>Microsoft Pushes Emergency Update</title>
+			<link>https://www.nytimes.com/2021/07/06/technology/microsoft-printnightmare.html</link>
+			<guid isPermaLink="true">https://www.nytimes.com/2021/07/06/technology/microsoft-printnightmare.html</guid>
+			<description>The flaw lets attackers take over computers.</description>
+			<dc:creator>Kellen Browning</dc:creator>
+			<pubDate>Tue, 06 Jul 2021 08:30:00 +0000</pubDate>
+			<category domain="http://www.nytimes.com/namespaces/keywords/nyt_org">Microsoft Corp</category>
+			<category>Computer Security</category>
+		</item>
+		<item>
+			<title>Amazon Sets a Date</title>
+			<link>https://www.nytimes.com/2021/07/06/technology/amazon-date.html</link>
+			<dc:creator>Karen Weise</dc:creator>
+			<dc:creator>Cade Metz</dc:creator>
+			<dc:creator>Daisuke Wakabayashi</dc:creator>
+			<pubDate>Tue, 06 Jul 2021 07:00:00 GMT</pubDate>
+		</item>
+	</channel>
+</rss>`
+
+func Test_ParseRSSFeed_ShouldParseFeed_WithValues(t *testing.T) {
+	articles, lastUpdated, err := nytapi.ParseRSSFeed(strings.NewReader(rssFeedXML))
+
+	require.Nil(t, err)
+	if assert.NotNil(t, lastUpdated) {
+		assert.Equal(t, time.Date(2021, 7, 6, 9, 12, 3, 0, time.UTC), lastUpdated.UTC())
+	}
+	if assert.NotNil(t, articles) && assert.Len(t, *articles, 2) {
+		assert.Equal(t, "Microsoft Pushes Emergency Update", (*articles)[0].Title)
+		assert.Equal(t, "The flaw lets attackers take over computers.", (*articles)[0].Abstract)
+		assert.Equal(t, "By Kellen Browning", (*articles)[0].Byline)
+		assert.Equal(t, []string{"Microsoft Corp"}, (*articles)[0].OrgFacet)
+		assert.Equal(t, []string{"Computer Security"}, (*articles)[0].DesFacet)
+		assert.Equal(t, "By Karen Weise, Cade Metz and Daisuke Wakabayashi", (*articles)[1].Byline)
+		assert.Equal(t, time.Date(2021, 7, 6, 7, 0, 0, 0, time.UTC), (*articles)[1].PublishedDate.UTC())
+		assert.Empty(t, (*articles)[1].Multimedia)
+	}
+}
+
+func Test_ParseRSSFeed_ShouldHandleInvalidFeed_WithError(t *testing.T) {
+	var cases = []struct {
+		feed string
+	}{
+		{""},
+		{"totally not valid"},
+		{`{"status": "OK"}`},
+		{`<html><body>Not a feed</body></html>`},
+	}
+
+	for _, tt := range cases {
+		articles, lastUpdated, err := nytapi.ParseRSSFeed(strings.NewReader(tt.feed))
+
+		require.Nil(t, articles)
+		require.Nil(t, lastUpdated)
+		assert.NotNil(t, err, tt.feed)
+	}
+}
+
+func Test_Client_ShouldHandleValid_FetchRSSFeed_WithValues(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gonyt-rss")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "Technology.xml"), []byte(rssFeedXML), 0644))
+
+	var requestedURL string
+	fileServer := http.FileServer(http.Dir(dir))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestedURL = req.URL.String()
+		fileServer.ServeHTTP(w, req)
+	}))
+	defer server.Close()
+
+	var cases = []struct {
+		feed string
+	}{
+		{"Technology"},
+		{"Technology.xml"},
+	}
+
+	for _, tt := range cases {
+		sut := nytapi.NewClient(server.Client(), "mockedApiKey")
+		sut.UseRSSBaseURL(server.URL + "/")
+
+		ctx := context.Background()
+		articles, lastUpdated, err := sut.FetchRSSFeed(ctx, tt.feed)
+
+		require.Nil(t, err)
+		assert.NotNil(t, lastUpdated)
+		if assert.NotNil(t, articles) {
+			assert.Len(t, *articles, 2)
+		}
+		assert.Equal(t, "/Technology.xml", requestedURL)
+	}
+}
+
+func Test_Client_ShouldHandleInvalid_FetchRSSFeed_WithError(t *testing.T) {
+	var requestedURL string
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestedURL = req.URL.String()
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	ctx := context.Background()
+	articles, lastUpdated, err := sut.FetchRSSFeed(ctx, "NotAFeed")
+
+	require.Nil(t, articles)
+	require.Nil(t, lastUpdated)
+	if assert.NotNil(t, err) {
+		assert.IsType(t, apierror.APIError{}, err)
+	}
+	assert.Equal(t, nytapi.RSSDefaultBaseURL+"/NotAFeed.xml", requestedURL)
+}
+
+func Test_Client_ShouldHandleInvalidFeed_FetchRSSFeed_withError(t *testing.T) {
+	var cases = []struct {
+		feed string
+	}{
+		{""},
+		{".xml"},
+		{"../World"},
+		{"World?api-key=1"},
+	}
+
+	requests := 0
+	mockedHTTPClient := port.MockedHTTPClient{
+		DoFunc: func(*http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{StatusCode: 404}, nil
+		},
+	}
+	apiKey := "mockedApiKey"
+	sut := nytapi.NewClient(&mockedHTTPClient, apiKey)
+
+	for _, tt := range cases {
+		ctx := context.Background()
+		articles, lastUpdated, err := sut.FetchRSSFeed(ctx, tt.feed)
+
+		require.Nil(t, articles)
+		require.Nil(t, lastUpdated)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 0, requests)
+}
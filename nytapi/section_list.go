@@ -0,0 +1,62 @@
+package nytapi
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// sectionListCache holds the section list once fetched, as it is needed to validate
+// every 'Top stories' request in live validation mode but changes rarely.
+type sectionListCache struct {
+	mutex    sync.Mutex
+	sections *[]WireSection
+}
+
+// get returns the cached section list, fetching it on first use. Failed fetches are not cached.
+func (cache *sectionListCache) get(ctx context.Context, fetch func(context.Context) (*[]WireSection, error)) (*[]WireSection, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if cache.sections != nil {
+		return cache.sections, nil
+	}
+
+	sections, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cache.sections = sections
+	return sections, nil
+}
+
+// Maps the sections of the section list to the known 'Top stories' sections they name, keyed by their normalized
+// spelling, e.g. "u.s." to "us" or "t magazine" to "t-magazine". Sections of the section list without a known
+// 'Top stories' section are skipped, as the API serves no top stories for them.
+func liveTopStoriesSections(sections *[]WireSection) map[string]TopStoriesSection {
+	known := map[string]TopStoriesSection{}
+	for _, section := range topStoriesSections {
+		known[normalizeSection(string(section))] = section
+	}
+
+	live := map[string]TopStoriesSection{}
+	for _, section := range *sections {
+		normalized := normalizeSection(section.Section)
+		if topStoriesSection, ok := known[normalized]; ok {
+			live[normalized] = topStoriesSection
+		}
+	}
+	return live
+}
+
+// Reduces a section to lower case letters and digits, as the section list names sections
+// for display while 'Top stories' uses them in URL paths.
+func normalizeSection(section string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, section)
+}
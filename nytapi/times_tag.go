@@ -0,0 +1,41 @@
+package nytapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TagFilter defined by the New York Times API as either: Des, Geo, Org, Per
+type TagFilter string
+
+// Valid 'TimesTags' filter as defined by the New York Times API.
+const (
+	TagFilterDescriptor   TagFilter = "Des"
+	TagFilterGeographic   TagFilter = "Geo"
+	TagFilterOrganization TagFilter = "Org"
+	TagFilterPerson       TagFilter = "Per"
+)
+
+// TimesTagsMaxSuggestions is the maximum number of suggestions the 'TimesTags' API delivers.
+const TimesTagsMaxSuggestions = 100
+
+// IsValid checks the validity of a 'TimesTags' filter.
+func (filter TagFilter) IsValid() error {
+	switch filter {
+	case TagFilterDescriptor, TagFilterGeographic, TagFilterOrganization, TagFilterPerson:
+		return nil
+	}
+	return fmt.Errorf("invalid tag filter: %v", filter)
+}
+
+// Joins filters into the format expected by the API, e.g. "(Per),(Org)".
+func formatTagFilters(filters []TagFilter) (string, error) {
+	formatted := []string{}
+	for _, filter := range filters {
+		if err := filter.IsValid(); err != nil {
+			return "", err
+		}
+		formatted = append(formatted, fmt.Sprintf("(%v)", filter))
+	}
+	return strings.Join(formatted, ","), nil
+}
@@ -0,0 +1,39 @@
+package nytapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+func Test_TagFilter_ShouldBeValid(t *testing.T) {
+	var cases = []struct {
+		filter nytapi.TagFilter
+	}{
+		{nytapi.TagFilterDescriptor},
+		{nytapi.TagFilterGeographic},
+		{nytapi.TagFilterOrganization},
+		{nytapi.TagFilterPerson},
+		{"Per"},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.filter.IsValid())
+	}
+}
+
+func Test_TagFilter_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		filter nytapi.TagFilter
+	}{
+		{""},
+		{"per"},
+		{"(Per)"},
+		{"nytd_per"},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.filter.IsValid())
+	}
+}
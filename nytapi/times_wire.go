@@ -0,0 +1,78 @@
+package nytapi
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// WireSource defined by the New York Times API as either: all, nyt, inyt
+type WireSource string
+
+// Valid 'Times wire' source as defined by the New York Times API.
+const (
+	AllSources WireSource = "all"
+	NYT        WireSource = "nyt"
+	INYT       WireSource = "inyt"
+)
+
+// IsValid checks the validity of a 'Times wire' source.
+func (source WireSource) IsValid() error {
+	switch source {
+	case AllSources, NYT, INYT:
+		return nil
+	}
+	return fmt.Errorf("invalid times wire source: %v", source)
+}
+
+// AllSections fetches the 'Times wire' content of every section.
+const AllSections = "all"
+
+// WireMaxLimit is the maximum number of articles the 'Times wire' API delivers per request.
+const WireMaxLimit = 500
+
+// WireMaxTimePeriod is the longest window of recent content the 'Times wire' API accepts.
+const WireMaxTimePeriod = 720 * time.Hour
+
+func isValidWireLimitAndOffset(limit int, offset int) error {
+	if limit < 0 || limit > WireMaxLimit {
+		return fmt.Errorf("invalid times wire limit: %v", limit)
+	}
+	if offset < 0 {
+		return fmt.Errorf("invalid times wire offset: %v", offset)
+	}
+	return nil
+}
+
+// Converts a time period into the whole hours expected by the API, rounding up partial hours.
+func wireTimePeriodHours(timePeriod time.Duration) (int, error) {
+	if timePeriod < 0 || timePeriod > WireMaxTimePeriod {
+		return 0, fmt.Errorf("invalid times wire time period: %v", timePeriod)
+	}
+
+	hours := int(timePeriod / time.Hour)
+	if timePeriod%time.Hour != 0 {
+		hours++
+	}
+	return hours, nil
+}
+
+// Reduces an article URL to the form known to the API, as shared links often carry tracking parameters.
+// Only URLs of nytimes.com are accepted.
+func normalizeArticleURL(articleURL string) (string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(articleURL))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return "", fmt.Errorf("invalid article url: %v", articleURL)
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if host != "nytimes.com" && !strings.HasSuffix(host, ".nytimes.com") {
+		return "", fmt.Errorf("invalid article url, not on nytimes.com: %v", articleURL)
+	}
+
+	parsed.Scheme = "https"
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String(), nil
+}
@@ -0,0 +1,36 @@
+package nytapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thorstenpfister/gonyt/nytapi"
+)
+
+func Test_WireSource_ShouldBeValid(t *testing.T) {
+	var cases = []struct {
+		source nytapi.WireSource
+	}{
+		{nytapi.AllSources},
+		{nytapi.NYT},
+		{nytapi.INYT},
+	}
+
+	for _, tt := range cases {
+		assert.Nil(t, tt.source.IsValid())
+	}
+}
+
+func Test_WireSource_ShouldBeInvalid(t *testing.T) {
+	var cases = []struct {
+		source nytapi.WireSource
+	}{
+		{""},
+		{"iht"},
+		{"NYT"},
+	}
+
+	for _, tt := range cases {
+		assert.Error(t, tt.source.IsValid())
+	}
+}
@@ -35,11 +35,25 @@ const (
 	World        TopStoriesSection = "world"
 )
 
+// Every 'Top stories' section known to this library, in alphabetical order.
+var topStoriesSections = []TopStoriesSection{
+	Arts, Automobiles, Books, Business, Fashion, Food, Health, Home, Insider, Magazine, Movies, Nyregion, Obituaries,
+	Opinion, Politics, Realestate, Science, Sports, Sundayreview, Technology, Theater, Tmagazine, Travel, Upshot, Us, World,
+}
+
+// TopStoriesSections returns every 'Top stories' section known to this library.
+func TopStoriesSections() []TopStoriesSection {
+	sections := make([]TopStoriesSection, len(topStoriesSections))
+	copy(sections, topStoriesSections)
+	return sections
+}
+
 // IsValid checks the validity of a 'Top stories' section.
 func (section TopStoriesSection) IsValid() error {
-	switch section {
-	case Arts, Automobiles, Books, Business, Fashion, Food, Health, Home, Insider, Magazine, Movies, Nyregion, Obituaries, Opinion, Politics, Realestate, Science, Sports, Sundayreview, Technology, Theater, Tmagazine, Travel, Upshot, Us, World:
-		return nil
+	for _, candidate := range topStoriesSections {
+		if section == candidate {
+			return nil
+		}
 	}
 	return fmt.Errorf("invalid 'Top Stories' section: %v", section)
 }
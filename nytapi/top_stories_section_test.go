@@ -127,3 +127,12 @@ func Test_TopStoriesSection_ShouldBeInvalid(t *testing.T) {
 		assert.Error(t, tt.section.IsValid())
 	}
 }
+
+func Test_TopStoriesSections_ShouldListValidSections_WithValues(t *testing.T) {
+	sections := nytapi.TopStoriesSections()
+
+	assert.Len(t, sections, 26)
+	for _, section := range sections {
+		assert.Nil(t, section.IsValid())
+	}
+}